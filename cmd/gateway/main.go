@@ -0,0 +1,69 @@
+// Command gateway runs the edge service in front of the temperature server:
+// it validates POST /temperature bodies and forwards valid CEPs to
+// TEMPERATURE_SERVICE_URL.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"temperature_server/pkg/gateway"
+	"temperature_server/pkg/middleware"
+	"temperature_server/pkg/tracing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func main() {
+	viper.SetConfigFile(".env")
+	viper.ReadInConfig()
+	viper.AutomaticEnv()
+	viper.SetDefault("GATEWAY_PORT", "8081")
+	viper.SetDefault("TEMPERATURE_SERVICE_URL", "http://localhost:8080")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(ctx, "gateway", viper.GetString("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	if err != nil {
+		log.Fatal("tracing: ", err)
+	}
+
+	mux := http.NewServeMux()
+	gateway.NewHandler(viper.GetString("TEMPERATURE_SERVICE_URL"), viper.GetString("TEMPERATURE_SERVICE_API_KEY")).Register(mux)
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	handler := middleware.Chain(mux,
+		tracing.Middleware("gateway"),
+		middleware.RequestID,
+		middleware.AccessLog(logger),
+		middleware.Recover(logger),
+	)
+
+	server := &http.Server{Addr: ":" + viper.GetString("GATEWAY_PORT"), Handler: handler}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("Gateway rodando na porta %s, encaminhando para %s\n", server.Addr, viper.GetString("TEMPERATURE_SERVICE_URL"))
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+
+	// Flush the spans still waiting to be batched.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Print("tracing: ", err)
+	}
+}
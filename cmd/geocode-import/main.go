@@ -0,0 +1,146 @@
+// Command geocode-import builds pkg/geocode/municipalities.csv from the
+// IBGE municipality list with coordinates and a CEP per municipality:
+//
+//	go run ./cmd/geocode-import -coordinates municipios.csv -ceps ceps.csv > pkg/geocode/municipalities.csv
+//
+// The coordinates file is the IBGE list as published with the columns
+// codigo_ibge, nome, latitude and longitude (others are ignored); the CEP
+// file has the columns ibge and cep. The output is checked with
+// geocode.Load before it is written.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"temperature_server/pkg/geocode"
+)
+
+// ufs maps the first two digits of an IBGE code to its UF.
+var ufs = map[string]string{
+	"11": "RO", "12": "AC", "13": "AM", "14": "RR", "15": "PA", "16": "AP", "17": "TO",
+	"21": "MA", "22": "PI", "23": "CE", "24": "RN", "25": "PB", "26": "PE", "27": "AL", "28": "SE", "29": "BA",
+	"31": "MG", "32": "ES", "33": "RJ", "35": "SP",
+	"41": "PR", "42": "SC", "43": "RS",
+	"50": "MS", "51": "MT", "52": "GO", "53": "DF",
+}
+
+func main() {
+	coordinatesPath := flag.String("coordinates", "", "IBGE municipality list with coordinates (CSV)")
+	cepsPath := flag.String("ceps", "", "CEP per municipality (CSV with ibge,cep)")
+	flag.Parse()
+	if *coordinatesPath == "" || *cepsPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	coordinates, err := os.Open(*coordinatesPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer coordinates.Close()
+	ceps, err := os.Open(*cepsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer ceps.Close()
+
+	if err := convert(coordinates, ceps, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// convert joins both files by IBGE code and writes the table in the
+// layout geocode.Load reads, sorted by IBGE code.
+func convert(coordinates, ceps io.Reader, out io.Writer) error {
+	cepByCode := map[string]string{}
+	if err := readColumns(ceps, []string{"ibge", "cep"}, func(values []string) error {
+		cepByCode[values[0]] = values[1]
+		return nil
+	}); err != nil {
+		return fmt.Errorf("ceps: %w", err)
+	}
+
+	var rows [][]string
+	var missing []string
+	if err := readColumns(coordinates, []string{"codigo_ibge", "nome", "latitude", "longitude"}, func(values []string) error {
+		code := values[0]
+		uf, ok := ufs[code[:min(2, len(code))]]
+		if !ok {
+			return fmt.Errorf("unknown UF for IBGE code %q", code)
+		}
+		cep, ok := cepByCode[code]
+		if !ok {
+			missing = append(missing, code)
+			return nil
+		}
+		rows = append(rows, []string{code, values[1], uf, values[2], values[3], cep})
+		return nil
+	}); err != nil {
+		return fmt.Errorf("coordinates: %w", err)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no CEP for %d municipalities: %s", len(missing), strings.Join(missing[:min(10, len(missing))], ", "))
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+
+	var table bytes.Buffer
+	writer := csv.NewWriter(&table)
+	writer.Write([]string{"ibge", "name", "uf", "lat", "lon", "cep"})
+	writer.WriteAll(rows)
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	if _, err := geocode.Load(bytes.NewReader(table.Bytes())); err != nil {
+		return err
+	}
+	_, err := out.Write(table.Bytes())
+	return err
+}
+
+// readColumns calls fn with the named columns of every record of a CSV
+// file with a header line.
+func readColumns(r io.Reader, names []string, fn func(values []string) error) error {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("header: %w", err)
+	}
+	indexes := make([]int, len(names))
+	for i, name := range names {
+		indexes[i] = -1
+		for j, column := range header {
+			if strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")), name) {
+				indexes[i] = j
+			}
+		}
+		if indexes[i] < 0 {
+			return fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	values := make([]string, len(names))
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for i, index := range indexes {
+			values[i] = strings.TrimSpace(record[index])
+		}
+		if err := fn(values); err != nil {
+			line, _ := reader.FieldPos(0)
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// Colunas como no arquivo publicado do IBGE, com colunas extras e fora de ordem
+const coordinates = "codigo_ibge,nome,latitude,longitude,capital,codigo_uf\n" +
+	"3107406,Bom Despacho,-19.7386,-45.2522,0,31\n" +
+	"3100203,Abaeté,-19.1551,-45.4444,0,31\n" +
+	"1100205,Porto Velho,-8.76077,-63.8999,1,11\n"
+
+const ceps = "cep,ibge\n35630-016,3107406\n35620-000,3100203\n76801-000,1100205\n"
+
+func TestConvert(t *testing.T) {
+	var out strings.Builder
+	if err := convert(strings.NewReader(coordinates), strings.NewReader(ceps), &out); err != nil {
+		t.Fatalf("convert() error: %v", err)
+	}
+	expected := "ibge,name,uf,lat,lon,cep\n" +
+		"1100205,Porto Velho,RO,-8.76077,-63.8999,76801-000\n" +
+		"3100203,Abaeté,MG,-19.1551,-45.4444,35620-000\n" +
+		"3107406,Bom Despacho,MG,-19.7386,-45.2522,35630-016\n"
+	if out.String() != expected {
+		t.Errorf("unexpected table:\n%s", out.String())
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	for name, input := range map[string][2]string{
+		"sem CEP":               {coordinates, "ibge,cep\n3107406,35630-016\n"},
+		"coluna faltando":       {"codigo_ibge,nome,latitude\n3107406,Bom Despacho,-19.7386\n", ceps},
+		"UF desconhecida":       {"codigo_ibge,nome,latitude,longitude\n9907406,Bom Despacho,-19.7386,-45.2522\n", ceps},
+		"CEP de outra UF":       {coordinates, "ibge,cep\n3107406,01001-000\n3100203,35620-000\n1100205,76801-000\n"},
+		"fora do Brasil":        {"codigo_ibge,nome,latitude,longitude\n3107406,Bom Despacho,40.7,-74.0\n", ceps},
+		"arquivo de CEPs vazio": {coordinates, ""},
+	} {
+		var out strings.Builder
+		if err := convert(strings.NewReader(input[0]), strings.NewReader(input[1]), &out); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+		if out.Len() != 0 {
+			t.Errorf("%s: expected no output, got %q", name, out.String())
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"temperature_server/internal/upstreamtest"
+	"temperature_server/pkg/alerts"
+	"temperature_server/pkg/gateway"
+	"temperature_server/pkg/geocode"
+	"temperature_server/pkg/graphql"
+	"temperature_server/pkg/middleware"
+	"temperature_server/pkg/openapi"
+	"temperature_server/pkg/stream"
+	"temperature_server/pkg/tracing"
+	v1 "temperature_server/pkg/v1"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newSpanRecorder instala um tracer provider que guarda os spans em memória.
+func newSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return recorder
+}
+
+// endedSpans espera até que n spans tenham terminado: o span do servidor
+// termina logo depois de a resposta ser enviada.
+func endedSpans(t *testing.T, recorder *tracetest.SpanRecorder, n int) []sdktrace.ReadOnlySpan {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for len(recorder.Ended()) < n && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	return recorder.Ended()
+}
+
+func TestGatewayEndToEnd(t *testing.T) {
+	upstreamtest.New(t, &viacep.BaseURL, &weather.BaseURL)
+	recorder := newSpanRecorder(t)
+
+	// Serviço de temperatura com as rotas e a validação reais
+	api, err := openapi.NewHandler()
+	if err != nil {
+		t.Fatalf("openapi.NewHandler() error: %v", err)
+	}
+	serviceMux := http.NewServeMux()
+	registerRoutes(serviceMux, routes{
+		Hub:     stream.NewHub(stream.FetchByKey, time.Minute),
+		Alerts:  alerts.NewStore(),
+		API:     api,
+		V1:      v1.NewHandler(),
+		GraphQL: graphql.NewHandler(),
+		Reverse: geocode.NewHandler(geocode.Embedded()),
+	})
+	var serviceRequests int
+	var serviceRequestID string
+	service := httptest.NewServer(middleware.Chain(serviceMux,
+		tracing.Middleware("temperature"),
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				serviceRequests++
+				serviceRequestID = r.Header.Get(middleware.RequestIDHeader)
+				next.ServeHTTP(w, r)
+			})
+		},
+		middleware.RequestID,
+		api.Middleware,
+	))
+	defer service.Close()
+
+	gatewayMux := http.NewServeMux()
+	gateway.NewHandler(service.URL, "").Register(gatewayMux)
+	edge := httptest.NewServer(middleware.Chain(gatewayMux, tracing.Middleware("gateway"), middleware.RequestID))
+	defer edge.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, edge.URL+"/temperature", strings.NewReader(`{"cep": "35630016"}`))
+	req.Header.Set(middleware.RequestIDHeader, "e2e-1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	var body gateway.CityTemperatureResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if body.City != "Bom Despacho" || body.Temp_C != 25 || body.Temp_F != 77 || body.Temp_K != 298.15 {
+		t.Errorf("unexpected response: %+v", body)
+	}
+	if serviceRequestID != "e2e-1" {
+		t.Errorf("expected the request ID to reach the service, got %q", serviceRequestID)
+	}
+
+	// Servidor do gateway, cliente do gateway e servidor do serviço no mesmo trace
+	spans := endedSpans(t, recorder, 3)
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	var client, serviceSpan sdktrace.ReadOnlySpan
+	for _, span := range spans {
+		if span.SpanContext().TraceID() != spans[0].SpanContext().TraceID() {
+			t.Errorf("span %q is in another trace", span.Name())
+		}
+		switch {
+		case span.SpanKind() == trace.SpanKindClient:
+			client = span
+		case span.SpanKind() == trace.SpanKindServer && span.Parent().IsRemote():
+			serviceSpan = span
+		}
+	}
+	if client == nil || serviceSpan == nil {
+		t.Fatalf("expected a client span and a remote-parented server span, got %v", spans)
+	}
+	if serviceSpan.Parent().SpanID() != client.SpanContext().SpanID() {
+		t.Errorf("expected the service span to be a child of the gateway's call")
+	}
+
+	// CEP inválido é barrado no gateway
+	resp, err = http.Post(edge.URL+"/temperature", "application/json", strings.NewReader(`{"cep": "35630-016"}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected status 422, got %d", resp.StatusCode)
+	}
+	if serviceRequests != 1 {
+		t.Errorf("expected only the valid CEP to reach the service, got %d requests", serviceRequests)
+	}
+}
@@ -0,0 +1,142 @@
+// Package upstreamtest mocks ViaCEP and WeatherAPI for tests that go through
+// the real lookup pipeline. It speaks the providers' wire format and does not
+// import the weather package, so weather's own tests can use it too.
+package upstreamtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ViaCEP, Search and Current are the routes served by default. ViaCEP
+// matches every /ws/{cep}/json lookup.
+const (
+	ViaCEP  = "/ws/"
+	Search  = "/v1/search.json"
+	Current = "/v1/current.json"
+)
+
+// Server answers ViaCEP under /ws and WeatherAPI under /v1. By default every
+// CEP is in Bom Despacho (MG), except 99999999, which ViaCEP does not know,
+// and the current reading is 25 °C, taken a minute ago.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	routes map[string]http.HandlerFunc
+	calls  map[string]int
+}
+
+// New starts a Server and points viaCEPURL and weatherAPIURL (viacep.BaseURL
+// and weather.BaseURL) and WEATHER_API_KEY at it until the test ends.
+func New(t *testing.T, viaCEPURL, weatherAPIURL *string) *Server {
+	t.Helper()
+	s := &Server{
+		routes: map[string]http.HandlerFunc{
+			ViaCEP:  viaCEP,
+			Search:  search,
+			Current: current,
+		},
+		calls: map[string]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	previousCEP, previousWeather := *viaCEPURL, *weatherAPIURL
+	previousKey := viper.GetString("WEATHER_API_KEY")
+	*viaCEPURL = s.URL + "/ws"
+	*weatherAPIURL = s.URL + "/v1"
+	viper.Set("WEATHER_API_KEY", "test-key")
+	t.Cleanup(func() {
+		s.Close()
+		*viaCEPURL, *weatherAPIURL = previousCEP, previousWeather
+		viper.Set("WEATHER_API_KEY", previousKey)
+	})
+	return s
+}
+
+// Handle serves route with handler, replacing the default one.
+func (s *Server) Handle(route string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[route] = handler
+}
+
+// JSON answers every request to route with v.
+func (s *Server) JSON(route string, v any) {
+	s.Handle(route, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(v)
+	})
+}
+
+// Calls counts the requests route received.
+func (s *Server) Calls(route string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[route]
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	route := r.URL.Path
+	if strings.HasPrefix(route, ViaCEP) {
+		route = ViaCEP
+	}
+
+	s.mu.Lock()
+	handler, ok := s.routes[route]
+	s.calls[route]++
+	s.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	handler(w, r)
+}
+
+// CEP returns the CEP of a /ws/{cep}/json request.
+func CEP(r *http.Request) string {
+	return strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, ViaCEP), "/json")
+}
+
+func viaCEP(w http.ResponseWriter, r *http.Request) {
+	cep := CEP(r)
+	if cep == "99999999" || len(cep) != 8 {
+		w.Write([]byte(`{"erro": "true"}`))
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{
+		"cep":        cep[:5] + "-" + cep[5:],
+		"localidade": "Bom Despacho",
+		"uf":         "MG",
+		"estado":     "Minas Gerais",
+		"ibge":       "3107406",
+	})
+}
+
+func search(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode([]map[string]any{{
+		"id":      1,
+		"name":    "Bom Despacho",
+		"region":  "Minas Gerais",
+		"country": "Brazil",
+		"lat":     -19.72,
+		"lon":     -45.25,
+	}})
+}
+
+func current(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]any{
+		"location": map[string]any{"name": "Bom Despacho", "region": "Minas Gerais", "country": "Brazil", "lat": -19.72, "lon": -45.25, "tz_id": "America/Sao_Paulo"},
+		"current": map[string]any{
+			"last_updated_epoch": time.Now().Add(-time.Minute).Unix(),
+			"temp_c":             25,
+		},
+	})
+}
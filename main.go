@@ -1,23 +1,268 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
+	"temperature_server/pkg/alerts"
+	"temperature_server/pkg/auth"
+	"temperature_server/pkg/geocode"
+	"temperature_server/pkg/graphql"
+	"temperature_server/pkg/grpcserver"
+	"temperature_server/pkg/history"
+	"temperature_server/pkg/middleware"
+	"temperature_server/pkg/openapi"
+	"temperature_server/pkg/ratelimit"
+	"temperature_server/pkg/stream"
+	"temperature_server/pkg/tracing"
+	v1 "temperature_server/pkg/v1"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/watchlist"
 	"temperature_server/pkg/weather"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
+// routes holds what registerRoutes mounts besides the weather handlers.
+// History, Watchlist and Keys are optional and left nil when their feature
+// is not configured.
+type routes struct {
+	History   http.Handler
+	Watchlist http.Handler
+	Keys      *auth.Handler
+	Hub       *stream.Hub
+	Alerts    *alerts.Store
+	API       *openapi.Handler
+	V1        *v1.Handler
+	GraphQL   *graphql.Handler
+	Reverse   *geocode.Handler
+	// LegacySunset is announced on deprecated routes when set.
+	LegacySunset time.Time
+}
+
+// registerRoutes mounts every HTTP endpoint on mux. pkg/openapi/openapi.yaml
+// documents them, and main_test.go checks the two stay in sync.
+func registerRoutes(mux *http.ServeMux, rt routes) {
+	mux.Handle("/temperature", v1.Deprecated(http.HandlerFunc(weather.TemperatureHandler), "/v1/temperature", rt.LegacySunset))
+	mux.HandleFunc("/indices", weather.IndicesHandler)
+	mux.HandleFunc("/weather", weather.WeatherHandler)
+	mux.HandleFunc("/air-quality", weather.AirQualityHandler)
+	mux.HandleFunc("/alerts", weather.AlertsHandler)
+	mux.HandleFunc("/astronomy", weather.AstronomyHandler)
+	if rt.History != nil {
+		mux.Handle("/history", rt.History)
+	}
+	if rt.Watchlist != nil {
+		mux.Handle("GET /admin/watchlist", rt.Watchlist)
+	}
+	mux.Handle("/temperature/stream", stream.NewSSEHandler(rt.Hub, stream.DefaultHeartbeat))
+	mux.Handle("/temperature/ws", stream.NewWebSocketHandler(rt.Hub))
+	alerts.NewHandler(rt.Alerts).Register(mux)
+	if rt.Keys != nil {
+		rt.Keys.Register(mux)
+	}
+	rt.API.Register(mux)
+	rt.V1.Register(mux)
+	rt.GraphQL.Register(mux)
+	rt.Reverse.Register(mux)
+}
+
 func main() {
 	viper.SetConfigFile(".env")
 	viper.ReadInConfig()
+	viper.AutomaticEnv()
+	viper.SetDefault("GRPC_PORT", "50051")
+	viper.SetDefault("STREAM_POLL_INTERVAL", "30s")
+	viper.SetDefault("ALERTS_INTERVAL", "5m")
+	viper.SetDefault("WATCHLIST_SCHEDULE", "@every 1h")
+	viper.SetDefault("WATCHLIST_JITTER", "1m")
+	viper.SetDefault("WATCHLIST_RATE", 2)
+	viper.SetDefault("WEATHERAPI_QUOTA_PERIOD", "24h")
+	viper.SetDefault("AUTH_DEFAULT_RATE_LIMIT", 60)
+	viper.SetDefault("AUTH_DEFAULT_DAILY_QUOTA", 1000)
+	viper.SetDefault("RATE_LIMIT_IP_RATE", 5)
+	viper.SetDefault("RATE_LIMIT_IP_BURST", 10)
+	viper.SetDefault("RATE_LIMIT_MAX_WAIT", "250ms")
+	viper.SetDefault("WEATHERAPI_RATE_LIMIT", 10)
+	viper.SetDefault("WEATHERAPI_BURST", 10)
+	viper.SetDefault("VIACEP_RATE_LIMIT", 5)
+	viper.SetDefault("VIACEP_BURST", 5)
+	viper.SetDefault("CORS_ALLOWED_ORIGINS", "*")
+	viper.SetDefault("CORS_ALLOWED_METHODS", "GET, POST, DELETE, OPTIONS")
+	viper.SetDefault("CORS_ALLOWED_HEADERS", "Accept, Accept-Language, Authorization, Content-Type, X-API-Key, X-Request-ID, traceparent, tracestate")
+	viper.SetDefault("CORS_EXPOSED_HEADERS", "Content-Language, Retry-After, X-Request-ID, X-Cache, Deprecation, Sunset, Link, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, X-Quota-Limit, X-Quota-Remaining, X-Quota-Reset")
+	viper.SetDefault("CORS_MAX_AGE", "10m")
+	viper.SetDefault("GZIP", true)
+	viper.SetDefault("WEATHER_REFRESH_INTERVAL", "15m")
+	viper.SetDefault("OPENAPI_VALIDATION", true)
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	// Packages without an injected logger log through the default one.
+	slog.SetDefault(logger)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Every upstream client uses http.DefaultTransport, so limiting it
+	// covers HTTP handlers, gRPC, streams and background jobs alike.
+	upstream := ratelimit.NewTransport(http.DefaultTransport, viper.GetDuration("RATE_LIMIT_MAX_WAIT"))
+	for baseURL, prefix := range map[string]string{weather.BaseURL: "WEATHERAPI", viacep.BaseURL: "VIACEP"} {
+		if u, err := url.Parse(baseURL); err == nil {
+			upstream.Limit(u.Hostname(), viper.GetFloat64(prefix+"_RATE_LIMIT"), viper.GetInt(prefix+"_BURST"))
+		}
+	}
+	http.DefaultTransport = upstream
+
+	weather.RefreshInterval = viper.GetDuration("WEATHER_REFRESH_INTERVAL")
+
+	var rt routes
+	var sink weather.Recorder
+	if path := viper.GetString("HISTORY_DB"); path != "" {
+		historyStore, err := history.Open(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer historyStore.Close()
+		weather.SetRecorder(historyStore)
+		rt.History = history.NewHandler(historyStore)
+		sink = historyStore
+	}
+
+	if path := viper.GetString("WATCHLIST_FILE"); path != "" {
+		entries, err := watchlist.LoadFile(path, viper.GetString("WATCHLIST_SCHEDULE"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if jsonlPath := viper.GetString("WATCHLIST_JSONL"); jsonlPath != "" {
+			jsonlSink, err := watchlist.NewJSONLSink(jsonlPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer jsonlSink.Close()
+			sink = jsonlSink
+		}
+		sampler := watchlist.NewScheduler(entries, sink, viper.GetDuration("WATCHLIST_JITTER"), logger)
+		sampler.Rate = viper.GetFloat64("WATCHLIST_RATE")
+		sampler.Quota = watchlist.NewQuota(viper.GetInt("WEATHERAPI_QUOTA"), viper.GetDuration("WEATHERAPI_QUOTA_PERIOD"))
+		rt.Watchlist = watchlist.StatusHandler(sampler)
+		go sampler.Run(ctx)
+	}
+
+	rt.Hub = stream.NewHub(stream.FetchByKey, viper.GetDuration("STREAM_POLL_INTERVAL"))
+
+	alerts.AllowPrivateWebhooks = viper.GetBool("ALERT_ALLOW_PRIVATE_WEBHOOKS")
+	rt.Alerts = alerts.NewStore()
+	rt.Alerts.DefaultSecret = viper.GetString("ALERT_WEBHOOK_SECRET")
+	scheduler := alerts.NewScheduler(rt.Alerts, alerts.NewDeliverer(), viper.GetDuration("ALERTS_INTERVAL"), logger)
+	go scheduler.Run(ctx)
+
+	var keyStore *auth.Store
+	if adminKey := viper.GetString("ADMIN_API_KEY"); adminKey != "" {
+		keyStore = auth.NewStore(viper.GetInt("AUTH_DEFAULT_RATE_LIMIT"), viper.GetInt("AUTH_DEFAULT_DAILY_QUOTA"))
+		noLimit := 0
+		if _, err := keyStore.Import(auth.CreateKeyRequest{Name: "admin", Admin: true, RateLimit: &noLimit, DailyQuota: &noLimit}, adminKey, time.Now()); err != nil {
+			log.Fatal("ADMIN_API_KEY: ", err)
+		}
+		rt.Keys = auth.NewHandler(keyStore)
+	}
+
+	api, err := openapi.NewHandler()
+	if err != nil {
+		log.Fatal("openapi: ", err)
+	}
+	rt.API = api
+
+	rt.V1 = v1.NewHandler()
+	if value := viper.GetString("LEGACY_SUNSET"); value != "" {
+		if rt.LegacySunset, err = time.Parse(weather.DateLayout, value); err != nil {
+			log.Fatal("LEGACY_SUNSET: ", err)
+		}
+	}
+
+	rt.GraphQL = graphql.NewHandler()
+	rt.Reverse = geocode.NewHandler(geocode.Embedded())
+
+	registerRoutes(http.DefaultServeMux, rt)
+
+	shutdownTracing, err := tracing.Setup(ctx, "temperature", viper.GetString("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	if err != nil {
+		log.Fatal("tracing: ", err)
+	}
+
+	chain := []middleware.Middleware{
+		tracing.Middleware("temperature"),
+		middleware.RequestID,
+		middleware.AccessLog(logger),
+		middleware.Recover(logger),
+		middleware.CORS(middleware.CORSConfig{
+			AllowedOrigins:   middleware.SplitList(viper.GetString("CORS_ALLOWED_ORIGINS")),
+			AllowedMethods:   middleware.SplitList(viper.GetString("CORS_ALLOWED_METHODS")),
+			AllowedHeaders:   middleware.SplitList(viper.GetString("CORS_ALLOWED_HEADERS")),
+			ExposedHeaders:   middleware.SplitList(viper.GetString("CORS_EXPOSED_HEADERS")),
+			AllowCredentials: viper.GetBool("CORS_ALLOW_CREDENTIALS"),
+			MaxAge:           viper.GetDuration("CORS_MAX_AGE"),
+		}),
+	}
+
+	trustedProxies, err := ratelimit.ParseTrustedProxies(viper.GetString("RATE_LIMIT_TRUSTED_PROXIES"))
+	if err != nil {
+		log.Fatal("RATE_LIMIT_TRUSTED_PROXIES: ", err)
+	}
+	ipLimiter := ratelimit.NewLimiter(viper.GetFloat64("RATE_LIMIT_IP_RATE"), viper.GetInt("RATE_LIMIT_IP_BURST"), viper.GetDuration("RATE_LIMIT_MAX_WAIT"))
+	if ipLimiter.Rate > 0 {
+		chain = append(chain, ratelimit.Middleware(ipLimiter, trustedProxies))
+	}
+	expvar.Publish("ratelimit", expvar.Func(func() any {
+		now := time.Now()
+		return map[string]any{"clients": ipLimiter.Stats(now), "upstreams": upstream.Stats(now)}
+	}))
+
+	if keyStore != nil {
+		chain = append(chain, auth.Middleware(keyStore))
+	}
+	if viper.GetBool("OPENAPI_VALIDATION") {
+		chain = append(chain, api.Middleware)
+	}
+
+	if viper.GetBool("GZIP") {
+		chain = append(chain, middleware.Gzip)
+	}
+	handler := middleware.Chain(http.DefaultServeMux, chain...)
+
+	server := &http.Server{Addr: ":8080", Handler: handler}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("Servidor rodando na porta %s\n", server.Addr)
+	fmt.Printf("Teste com: http://localhost%s/temperature?cep=35620-000\n", server.Addr)
 
-	http.HandleFunc("/temperature", weather.TemperatureHandler)
+	grpcPort := ":" + viper.GetString("GRPC_PORT")
+	go func() {
+		fmt.Printf("Servidor gRPC rodando na porta %s\n", grpcPort)
+		log.Fatal(grpcserver.ListenAndServe(grpcPort, grpcserver.NewServer()))
+	}()
 
-	port := ":8080"
-	fmt.Printf("Servidor rodando na porta %s\n", port)
-	fmt.Printf("Teste com: http://localhost%s/temperature?cep=35620-000\n", port)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
 
-	log.Fatal(http.ListenAndServe(port, nil))
+	// Flush the spans still waiting to be batched.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Print("tracing: ", err)
+	}
 }
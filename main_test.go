@@ -4,9 +4,18 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"strings"
+	"temperature_server/pkg/alerts"
+	"temperature_server/pkg/auth"
+	"temperature_server/pkg/geocode"
+	"temperature_server/pkg/graphql"
+	"temperature_server/pkg/openapi"
+	"temperature_server/pkg/stream"
+	v1 "temperature_server/pkg/v1"
 	"temperature_server/pkg/weather"
 	"testing"
+	"time"
 )
 
 func TestMainIntegration_ServerStartup(t *testing.T) {
@@ -206,3 +215,34 @@ func TestMainIntegration_ResponseHeaders(t *testing.T) {
 		t.Errorf("Expected application/json content type, got %s", contentType)
 	}
 }
+
+func TestRoutesMatchSpec(t *testing.T) {
+	// Todas as operações documentadas em pkg/openapi/openapi.yaml precisam
+	// ter uma rota registrada, com todos os recursos opcionais habilitados
+	api, err := openapi.NewHandler()
+	if err != nil {
+		t.Fatalf("openapi.NewHandler() error: %v", err)
+	}
+	mux := http.NewServeMux()
+	registerRoutes(mux, routes{
+		History:   http.NotFoundHandler(),
+		Watchlist: http.NotFoundHandler(),
+		Keys:      auth.NewHandler(auth.NewStore(0, 0)),
+		Hub:       stream.NewHub(stream.FetchByKey, time.Minute),
+		Alerts:    alerts.NewStore(),
+		API:       api,
+		V1:        v1.NewHandler(),
+		GraphQL:   graphql.NewHandler(),
+		Reverse:   geocode.NewHandler(geocode.Embedded()),
+	})
+
+	pathParam := regexp.MustCompile(`\{[^}]+\}`)
+	for path, item := range api.Doc.Paths.Map() {
+		for method := range item.Operations() {
+			req := httptest.NewRequest(method, pathParam.ReplaceAllString(path, "example"), nil)
+			if _, pattern := mux.Handler(req); pattern == "" {
+				t.Errorf("%s %s is documented but has no route", method, path)
+			}
+		}
+	}
+}
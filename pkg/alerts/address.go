@@ -0,0 +1,60 @@
+package alerts
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+// AllowPrivateWebhooks lets webhooks target loopback, private and
+// link-local addresses, for local development.
+var AllowPrivateWebhooks = false
+
+var ErrPrivateAddress = errors.New("webhook_url must not point to a private address")
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// blocked reports whether addr is loopback, private (RFC 1918 and unique
+// local), link-local (including the 169.254.169.254 metadata endpoint),
+// shared, multicast or unspecified.
+func blocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr)
+}
+
+// checkWebhookHost rejects hosts that are a blocked address or a localhost
+// name. Other names are checked once resolved, by dialControl.
+func checkWebhookHost(host string) error {
+	if AllowPrivateWebhooks {
+		return nil
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrPrivateAddress
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && blocked(addr) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// dialControl runs on every connection the Deliverer opens, after DNS
+// resolution, so a name that resolved to a public address when the rule
+// was created cannot be rebound to a private one later.
+func dialControl(network, address string, _ syscall.RawConn) error {
+	if AllowPrivateWebhooks {
+		return nil
+	}
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if blocked(addrPort.Addr()) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package alerts
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/weather"
+)
+
+type CreateRuleRequest struct {
+	CEP             string   `json:"cep"`
+	Metric          string   `json:"metric"`
+	Operator        Operator `json:"operator"`
+	Threshold       float64  `json:"threshold"`
+	Hysteresis      float64  `json:"hysteresis"`
+	CooldownSeconds int      `json:"cooldown_seconds"`
+	WebhookURL      string   `json:"webhook_url"`
+	Secret          string   `json:"secret"`
+}
+
+type Handler struct {
+	Store *Store
+}
+
+func NewHandler(store *Store) *Handler {
+	return &Handler{Store: store}
+}
+
+// Register mounts the rule API on mux:
+//
+//	POST   /alert-rules
+//	GET    /alert-rules
+//	GET    /alert-rules/{id}
+//	DELETE /alert-rules/{id}
+//	GET    /alert-rules/{id}/deliveries
+//	GET    /alert-deliveries
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /alert-rules", h.createRule)
+	mux.HandleFunc("GET /alert-rules", h.listRules)
+	mux.HandleFunc("GET /alert-rules/{id}", h.getRule)
+	mux.HandleFunc("DELETE /alert-rules/{id}", h.deleteRule)
+	mux.HandleFunc("GET /alert-rules/{id}/deliveries", h.ruleDeliveries)
+	mux.HandleFunc("GET /alert-deliveries", h.listDeliveries)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	lang := i18n.FromRequest(r)
+	w.Header().Set("Content-Language", lang)
+	writeJSON(w, status, weather.ErrorResponse{Error: i18n.Message(lang, message)})
+}
+
+func (h *Handler) createRule(w http.ResponseWriter, r *http.Request) {
+	var req CreateRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	rule, err := h.Store.Create(Rule{
+		CEP:             req.CEP,
+		Metric:          req.Metric,
+		Operator:        req.Operator,
+		Threshold:       req.Threshold,
+		Hysteresis:      req.Hysteresis,
+		CooldownSeconds: req.CooldownSeconds,
+		WebhookURL:      req.WebhookURL,
+		Secret:          req.Secret,
+	})
+	if err != nil {
+		writeError(w, r, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	w.Header().Set("Location", "/alert-rules/"+rule.ID)
+	writeJSON(w, http.StatusCreated, rule)
+}
+
+func (h *Handler) listRules(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.Store.List())
+}
+
+func (h *Handler) getRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := h.Store.Get(r.PathValue("id"))
+	if errors.Is(err, ErrRuleNotFound) {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func (h *Handler) deleteRule(w http.ResponseWriter, r *http.Request) {
+	if err := h.Store.Delete(r.PathValue("id")); err != nil {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ruleDeliveries(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.Store.Get(id); err != nil {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, h.Store.Deliveries(id))
+}
+
+func (h *Handler) listDeliveries(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.Store.Deliveries(r.URL.Query().Get("rule_id")))
+}
@@ -0,0 +1,116 @@
+package alerts
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestAPI(t *testing.T) (*Store, *httptest.Server) {
+	t.Helper()
+	store := NewStore()
+	mux := http.NewServeMux()
+	NewHandler(store).Register(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return store, server
+}
+
+func get(t *testing.T, url string) *http.Response {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestAPI_CreateGetListDelete(t *testing.T) {
+	_, server := newTestAPI(t)
+
+	body := `{"cep":"35630-016","metric":"temp_c","operator":">","threshold":35,"hysteresis":1,"cooldown_seconds":600,"webhook_url":"https://example.com/hook","secret":"s3cr3t"}`
+	resp, err := http.Post(server.URL+"/alert-rules", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", resp.StatusCode)
+	}
+
+	var raw map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&raw)
+	if _, ok := raw["secret"]; ok {
+		t.Error("Secret must not be returned by the API")
+	}
+	id, _ := raw["id"].(string)
+	if id == "" || resp.Header.Get("Location") != "/alert-rules/"+id {
+		t.Fatalf("Expected id and Location header, got %v and %s", raw["id"], resp.Header.Get("Location"))
+	}
+
+	getResp := get(t, server.URL+"/alert-rules/"+id)
+	var rule Rule
+	json.NewDecoder(getResp.Body).Decode(&rule)
+	if rule.Threshold != 35 || rule.State != StateOK {
+		t.Errorf("Unexpected rule: %+v", rule)
+	}
+
+	listResp := get(t, server.URL+"/alert-rules")
+	var rules []Rule
+	json.NewDecoder(listResp.Body).Decode(&rules)
+	if len(rules) != 1 {
+		t.Errorf("Expected 1 rule, got %d", len(rules))
+	}
+
+	deliveriesResp := get(t, server.URL+"/alert-rules/"+id+"/deliveries")
+	if deliveriesResp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 for deliveries, got %d", deliveriesResp.StatusCode)
+	}
+
+	req, _ := http.NewRequest(http.MethodDelete, server.URL+"/alert-rules/"+id, nil)
+	deleteResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	deleteResp.Body.Close()
+	if deleteResp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", deleteResp.StatusCode)
+	}
+
+	missingResp := get(t, server.URL+"/alert-rules/"+id)
+	if missingResp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", missingResp.StatusCode)
+	}
+}
+
+func TestAPI_CreateInvalidRule(t *testing.T) {
+	_, server := newTestAPI(t)
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{name: "JSON inválido", body: `{`, status: http.StatusBadRequest},
+		{name: "métrica desconhecida", body: `{"cep":"35630016","metric":"foo","operator":">","webhook_url":"https://example.com"}`, status: http.StatusUnprocessableEntity},
+		{name: "sem webhook", body: `{"cep":"35630016","metric":"temp_c","operator":">"}`, status: http.StatusUnprocessableEntity},
+		{name: "sem secret", body: `{"cep":"35630016","metric":"temp_c","operator":">","webhook_url":"https://example.com"}`, status: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(server.URL+"/alert-rules", "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Failed to make request: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+		})
+	}
+}
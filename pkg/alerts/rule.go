@@ -0,0 +1,183 @@
+package alerts
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+type Operator string
+
+const (
+	Above        Operator = ">"
+	AboveOrEqual Operator = ">="
+	Below        Operator = "<"
+	BelowOrEqual Operator = "<="
+)
+
+func (o Operator) Valid() bool {
+	switch o {
+	case Above, AboveOrEqual, Below, BelowOrEqual:
+		return true
+	}
+	return false
+}
+
+func (o Operator) matches(value, threshold float64) bool {
+	switch o {
+	case Above:
+		return value > threshold
+	case AboveOrEqual:
+		return value >= threshold
+	case Below:
+		return value < threshold
+	case BelowOrEqual:
+		return value <= threshold
+	}
+	return false
+}
+
+// cleared reports whether value has moved back past the threshold by at
+// least hysteresis, which re-arms a rule that has fired.
+func (o Operator) cleared(value, threshold, hysteresis float64) bool {
+	switch o {
+	case Above, AboveOrEqual:
+		return value <= threshold-hysteresis
+	default:
+		return value >= threshold+hysteresis
+	}
+}
+
+type State string
+
+const (
+	StateOK        State = "ok"
+	StateTriggered State = "triggered"
+)
+
+type Rule struct {
+	ID              string    `json:"id"`
+	CEP             string    `json:"cep"`
+	Metric          string    `json:"metric"`
+	Operator        Operator  `json:"operator"`
+	Threshold       float64   `json:"threshold"`
+	Hysteresis      float64   `json:"hysteresis"`
+	CooldownSeconds int       `json:"cooldown_seconds"`
+	WebhookURL      string    `json:"webhook_url"`
+	Secret          string    `json:"-"`
+	CreatedAt       time.Time `json:"created_at"`
+
+	State       State      `json:"state"`
+	LastValue   *float64   `json:"last_value,omitempty"`
+	LastFiredAt *time.Time `json:"last_fired_at,omitempty"`
+}
+
+func (r *Rule) Cooldown() time.Duration {
+	return time.Duration(r.CooldownSeconds) * time.Second
+}
+
+func (r *Rule) Validate() error {
+	if strings.TrimSpace(r.CEP) == "" {
+		return errors.New("invalid zipcode")
+	}
+	if _, ok := metricFields[r.Metric]; !ok {
+		return fmt.Errorf("unknown metric %q", r.Metric)
+	}
+	if !r.Operator.Valid() {
+		return fmt.Errorf("unknown operator %q", r.Operator)
+	}
+	if r.Hysteresis < 0 {
+		return errors.New("hysteresis must not be negative")
+	}
+	if r.CooldownSeconds < 0 {
+		return errors.New("cooldown_seconds must not be negative")
+	}
+	parsed, err := url.Parse(r.WebhookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("invalid webhook_url")
+	}
+	if err := checkWebhookHost(parsed.Hostname()); err != nil {
+		return err
+	}
+	if r.Secret == "" {
+		return errors.New("secret is required")
+	}
+	return nil
+}
+
+// metricFields maps the json name of every numeric field of
+// weather.Current (temp_c, humidity, wind_kph, ...) to its index.
+var metricFields = func() map[string]int {
+	fields := map[string]int{}
+	t := reflect.TypeOf(weather.Current{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch field.Type.Kind() {
+		case reflect.Float64, reflect.Int, reflect.Int64:
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name != "" && name != "last_updated_epoch" {
+				fields[name] = i
+			}
+		}
+	}
+	return fields
+}()
+
+func Metrics() []string {
+	names := make([]string, 0, len(metricFields))
+	for name := range metricFields {
+		names = append(names, name)
+	}
+	return names
+}
+
+func MetricValue(current weather.Current, metric string) (float64, bool) {
+	index, ok := metricFields[metric]
+	if !ok {
+		return 0, false
+	}
+	value := reflect.ValueOf(current).Field(index)
+	if value.CanFloat() {
+		return value.Float(), true
+	}
+	return float64(value.Int()), true
+}
+
+type Transition string
+
+const (
+	Triggered Transition = "triggered"
+	Resolved  Transition = "resolved"
+)
+
+// Evaluate updates the rule with a new reading and reports whether a
+// notification should be sent. A triggered rule only resolves, and can
+// fire again, once the value clears the threshold by the hysteresis
+// margin; notifications within the cooldown of the last one are skipped.
+func (r *Rule) Evaluate(value float64, now time.Time) (Transition, bool) {
+	r.LastValue = &value
+
+	switch r.State {
+	case StateTriggered:
+		if !r.Operator.cleared(value, r.Threshold, r.Hysteresis) {
+			return "", false
+		}
+		r.State = StateOK
+		return Resolved, true
+	default:
+		if !r.Operator.matches(value, r.Threshold) {
+			r.State = StateOK
+			return "", false
+		}
+		if r.LastFiredAt != nil && now.Sub(*r.LastFiredAt) < r.Cooldown() {
+			return "", false
+		}
+		r.State = StateTriggered
+		r.LastFiredAt = &now
+		return Triggered, true
+	}
+}
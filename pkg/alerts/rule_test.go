@@ -0,0 +1,129 @@
+package alerts
+
+import (
+	"encoding/json"
+	"strings"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+func TestRuleEvaluate_Hysteresis(t *testing.T) {
+	// Alerta de calor: dispara acima de 35°C e só rearma abaixo de 34°C
+	rule := Rule{Metric: "temp_c", Operator: Above, Threshold: 35, Hysteresis: 1}
+	now := time.Now()
+
+	steps := []struct {
+		value      float64
+		transition Transition
+		fire       bool
+	}{
+		{value: 30, fire: false},
+		{value: 35.5, transition: Triggered, fire: true},
+		{value: 36, fire: false},
+		{value: 34.5, fire: false},
+		{value: 35.2, fire: false},
+		{value: 34, transition: Resolved, fire: true},
+		{value: 35.1, transition: Triggered, fire: true},
+	}
+
+	for i, step := range steps {
+		transition, fire := rule.Evaluate(step.value, now.Add(time.Duration(i)*time.Minute))
+		if fire != step.fire || transition != step.transition {
+			t.Errorf("step %d (%.1f): got (%q, %v), expected (%q, %v)", i, step.value, transition, fire, step.transition, step.fire)
+		}
+	}
+}
+
+func TestRuleEvaluate_FrostAndCooldown(t *testing.T) {
+	// Alerta de geada: abaixo de 2°C, com 1 hora de cooldown
+	rule := Rule{Metric: "temp_c", Operator: Below, Threshold: 2, Hysteresis: 0.5, CooldownSeconds: 3600}
+	start := time.Now()
+
+	// Uma regra que nunca disparou não expõe last_fired_at
+	if body, _ := json.Marshal(rule); strings.Contains(string(body), "last_fired_at") {
+		t.Errorf("Expected no last_fired_at before firing, got %s", body)
+	}
+	if transition, fire := rule.Evaluate(1.5, start); !fire || transition != Triggered {
+		t.Fatalf("Expected trigger, got (%q, %v)", transition, fire)
+	}
+	if rule.LastFiredAt == nil || !rule.LastFiredAt.Equal(start) {
+		t.Errorf("Expected last fired at %v, got %v", start, rule.LastFiredAt)
+	}
+	if transition, fire := rule.Evaluate(3, start.Add(10*time.Minute)); !fire || transition != Resolved {
+		t.Fatalf("Expected resolve, got (%q, %v)", transition, fire)
+	}
+	if _, fire := rule.Evaluate(1, start.Add(20*time.Minute)); fire {
+		t.Error("Expected cooldown to suppress a new trigger")
+	}
+	if transition, fire := rule.Evaluate(1, start.Add(61*time.Minute)); !fire || transition != Triggered {
+		t.Errorf("Expected trigger after cooldown, got (%q, %v)", transition, fire)
+	}
+}
+
+func TestMetricValue(t *testing.T) {
+	current := weather.Current{TempC: 25.5, Humidity: 60, WindKph: 12.3}
+
+	tests := []struct {
+		metric   string
+		expected float64
+		ok       bool
+	}{
+		{metric: "temp_c", expected: 25.5, ok: true},
+		{metric: "humidity", expected: 60, ok: true},
+		{metric: "wind_kph", expected: 12.3, ok: true},
+		{metric: "wind_dir", ok: false},
+		{metric: "unknown", ok: false},
+	}
+
+	for _, tt := range tests {
+		value, ok := MetricValue(current, tt.metric)
+		if ok != tt.ok || value != tt.expected {
+			t.Errorf("MetricValue(%s) = (%f, %v), expected (%f, %v)", tt.metric, value, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	valid := Rule{CEP: "35630016", Metric: "temp_c", Operator: Above, Threshold: 35, WebhookURL: "https://example.com/hook", Secret: "s3cr3t"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected valid rule, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(r *Rule)
+	}{
+		{name: "sem CEP", mutate: func(r *Rule) { r.CEP = "" }},
+		{name: "métrica desconhecida", mutate: func(r *Rule) { r.Metric = "temperature" }},
+		{name: "operador inválido", mutate: func(r *Rule) { r.Operator = "!=" }},
+		{name: "histerese negativa", mutate: func(r *Rule) { r.Hysteresis = -1 }},
+		{name: "cooldown negativo", mutate: func(r *Rule) { r.CooldownSeconds = -1 }},
+		{name: "webhook inválido", mutate: func(r *Rule) { r.WebhookURL = "ftp://example.com" }},
+		{name: "loopback", mutate: func(r *Rule) { r.WebhookURL = "http://127.0.0.1:8080/hook" }},
+		{name: "localhost", mutate: func(r *Rule) { r.WebhookURL = "http://localhost/hook" }},
+		{name: "IPv6 loopback", mutate: func(r *Rule) { r.WebhookURL = "http://[::1]/hook" }},
+		{name: "RFC 1918", mutate: func(r *Rule) { r.WebhookURL = "https://10.0.0.5/hook" }},
+		{name: "RFC 1918 mapeado em IPv6", mutate: func(r *Rule) { r.WebhookURL = "https://[::ffff:192.168.0.1]/hook" }},
+		{name: "metadados da nuvem", mutate: func(r *Rule) { r.WebhookURL = "http://169.254.169.254/latest/meta-data" }},
+		{name: "sem secret", mutate: func(r *Rule) { r.Secret = "" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := valid
+			tt.mutate(&rule)
+			if err := rule.Validate(); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
+
+func TestRuleValidate_AllowPrivateWebhooks(t *testing.T) {
+	allowPrivateWebhooks(t)
+	rule := Rule{CEP: "35630016", Metric: "temp_c", Operator: Above, Threshold: 35, WebhookURL: "http://127.0.0.1:8080/hook", Secret: "s3cr3t"}
+	if err := rule.Validate(); err != nil {
+		t.Errorf("Expected local webhook to be allowed, got %v", err)
+	}
+}
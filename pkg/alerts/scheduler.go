@@ -0,0 +1,98 @@
+package alerts
+
+import (
+	"context"
+	"log/slog"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+const DefaultInterval = 5 * time.Minute
+
+type Scheduler struct {
+	Store     *Store
+	Deliverer *Deliverer
+	// Fetch is weather.GetWeatherByCEP outside of tests.
+	Fetch    func(cep string) (*weather.WeatherResponse, error)
+	Interval time.Duration
+	Logger   *slog.Logger
+}
+
+func NewScheduler(store *Store, deliverer *Deliverer, interval time.Duration, logger *slog.Logger) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Scheduler{
+		Store:     store,
+		Deliverer: deliverer,
+		Fetch:     weather.GetWeatherByCEP,
+		Interval:  interval,
+		Logger:    logger,
+	}
+}
+
+// Run evaluates every rule once per interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.EvaluateOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EvaluateOnce fetches each monitored CEP a single time, evaluates its
+// rules and delivers the resulting webhooks.
+func (s *Scheduler) EvaluateOnce(ctx context.Context) []Delivery {
+	byCEP := map[string][]Rule{}
+	for _, rule := range s.Store.List() {
+		byCEP[rule.CEP] = append(byCEP[rule.CEP], rule)
+	}
+
+	var deliveries []Delivery
+	for cep, rules := range byCEP {
+		weatherData, err := s.Fetch(cep)
+		if err != nil {
+			s.Logger.Warn("alerts: can not fetch", slog.String("cep", cep), slog.String("error", err.Error()))
+			continue
+		}
+
+		for _, rule := range rules {
+			value, ok := MetricValue(weatherData.Current, rule.Metric)
+			if !ok {
+				continue
+			}
+
+			var transition Transition
+			var fire bool
+			updated, exists := s.Store.update(rule.ID, func(r *Rule) {
+				transition, fire = r.Evaluate(value, time.Now())
+			})
+			if !exists || !fire {
+				continue
+			}
+
+			event := Event{
+				DeliveryID: newID(),
+				Event:      transition,
+				RuleID:     updated.ID,
+				CEP:        updated.CEP,
+				Metric:     updated.Metric,
+				Operator:   updated.Operator,
+				Threshold:  updated.Threshold,
+				Value:      value,
+				ObservedAt: weatherData.Current.LastUpdatedEpoch,
+				SentAt:     time.Now().UTC(),
+			}
+			delivery := s.Deliverer.Deliver(ctx, updated, event)
+			s.Store.RecordDelivery(delivery)
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries
+}
@@ -0,0 +1,100 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"temperature_server/pkg/weather"
+	"testing"
+)
+
+type fakeWeather struct {
+	mu    sync.Mutex
+	temps map[string]float64
+	calls map[string]int
+}
+
+func (f *fakeWeather) Fetch(cep string) (*weather.WeatherResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[cep]++
+	return &weather.WeatherResponse{Current: weather.Current{TempC: f.temps[cep], LastUpdatedEpoch: 1700000000}}, nil
+}
+
+func TestScheduler_EvaluateOnce(t *testing.T) {
+	allowPrivateWebhooks(t)
+	receiver := &webhookReceiver{t: t, secret: "global"}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	store := NewStore()
+	store.DefaultSecret = "global"
+	heat, _ := store.Create(Rule{CEP: "35630016", Metric: "temp_c", Operator: Above, Threshold: 35, Hysteresis: 1, WebhookURL: server.URL})
+	frost, _ := store.Create(Rule{CEP: "35630016", Metric: "temp_c", Operator: Below, Threshold: 2, WebhookURL: server.URL})
+
+	upstream := &fakeWeather{temps: map[string]float64{"35630016": 36}, calls: map[string]int{}}
+	scheduler := NewScheduler(store, newTestDeliverer(), 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scheduler.Fetch = upstream.Fetch
+
+	deliveries := scheduler.EvaluateOnce(context.Background())
+	if len(deliveries) != 1 || !deliveries[0].Success || deliveries[0].RuleID != heat.ID {
+		t.Fatalf("Expected one successful delivery for the heat rule, got %+v", deliveries)
+	}
+	if upstream.calls["35630016"] != 1 {
+		t.Errorf("Expected one fetch per CEP, got %d", upstream.calls["35630016"])
+	}
+
+	// Ainda acima do limite: nenhum novo webhook
+	if deliveries := scheduler.EvaluateOnce(context.Background()); len(deliveries) != 0 {
+		t.Errorf("Expected no deliveries while still triggered, got %d", len(deliveries))
+	}
+
+	upstream.temps["35630016"] = 30
+	deliveries = scheduler.EvaluateOnce(context.Background())
+	if len(deliveries) != 1 || deliveries[0].Event != Resolved {
+		t.Errorf("Expected a resolved delivery, got %+v", deliveries)
+	}
+
+	events := receiver.received()
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 webhook events, got %d", len(events))
+	}
+	if events[0].Event != Triggered || events[0].Value != 36 || events[0].CEP != "35630016" {
+		t.Errorf("Unexpected triggered event: %+v", events[0])
+	}
+
+	if log := store.Deliveries(heat.ID); len(log) != 2 {
+		t.Errorf("Expected 2 deliveries in the log, got %d", len(log))
+	}
+	if log := store.Deliveries(frost.ID); len(log) != 0 {
+		t.Errorf("Expected no deliveries for the frost rule, got %d", len(log))
+	}
+
+	rule, _ := store.Get(heat.ID)
+	if rule.State != StateOK || rule.LastValue == nil || *rule.LastValue != 30 {
+		t.Errorf("Unexpected rule state: %+v", rule)
+	}
+}
+
+func TestScheduler_LogsFetchErrors(t *testing.T) {
+	store := NewStore()
+	store.Create(Rule{CEP: "35630016", Metric: "temp_c", Operator: Above, Threshold: 35, WebhookURL: "https://example.com/hook", Secret: "s3cr3t"})
+
+	var logs bytes.Buffer
+	scheduler := NewScheduler(store, newTestDeliverer(), 0, slog.New(slog.NewJSONHandler(&logs, nil)))
+	scheduler.Fetch = func(cep string) (*weather.WeatherResponse, error) {
+		return nil, errors.New("upstream down")
+	}
+
+	if deliveries := scheduler.EvaluateOnce(context.Background()); len(deliveries) != 0 {
+		t.Errorf("Expected no deliveries, got %d", len(deliveries))
+	}
+	if !strings.Contains(logs.String(), `"cep":"35630016"`) || !strings.Contains(logs.String(), `"error":"upstream down"`) {
+		t.Errorf("Expected the fetch error to be logged, got %s", logs.String())
+	}
+}
@@ -0,0 +1,139 @@
+package alerts
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrRuleNotFound = errors.New("rule not found")
+
+const maxDeliveries = 1000
+
+type Delivery struct {
+	ID         string     `json:"id"`
+	RuleID     string     `json:"rule_id"`
+	Event      Transition `json:"event"`
+	URL        string     `json:"url"`
+	Attempts   int        `json:"attempts"`
+	StatusCode int        `json:"status_code,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	Success    bool       `json:"success"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Store keeps rules and the most recent deliveries in memory.
+type Store struct {
+	mu         sync.Mutex
+	rules      map[string]*Rule
+	deliveries []Delivery
+
+	// DefaultSecret signs webhooks of rules created without their own
+	// secret.
+	DefaultSecret string
+}
+
+func NewStore() *Store {
+	return &Store{rules: map[string]*Rule{}}
+}
+
+func newID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *Store) Create(rule Rule) (Rule, error) {
+	if rule.Secret == "" {
+		rule.Secret = s.DefaultSecret
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule.ID = newID()
+	rule.State = StateOK
+	rule.CreatedAt = time.Now().UTC()
+	s.rules[rule.ID] = &rule
+	return rule, nil
+}
+
+func (s *Store) Get(id string) (Rule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule, ok := s.rules[id]
+	if !ok {
+		return Rule{}, ErrRuleNotFound
+	}
+	return *rule, nil
+}
+
+func (s *Store) List() []Rule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := make([]Rule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, *rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].CreatedAt.Before(rules[j].CreatedAt)
+	})
+	return rules
+}
+
+func (s *Store) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.rules[id]; !ok {
+		return ErrRuleNotFound
+	}
+	delete(s.rules, id)
+	return nil
+}
+
+// update applies fn to the stored rule, if it still exists.
+func (s *Store) update(id string, fn func(rule *Rule)) (Rule, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule, ok := s.rules[id]
+	if !ok {
+		return Rule{}, false
+	}
+	fn(rule)
+	return *rule, true
+}
+
+func (s *Store) RecordDelivery(delivery Delivery) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries = append(s.deliveries, delivery)
+	if len(s.deliveries) > maxDeliveries {
+		s.deliveries = s.deliveries[len(s.deliveries)-maxDeliveries:]
+	}
+}
+
+// Deliveries returns the delivery log, newest first, optionally filtered
+// by rule.
+func (s *Store) Deliveries(ruleID string) []Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deliveries := []Delivery{}
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		if ruleID == "" || s.deliveries[i].RuleID == ruleID {
+			deliveries = append(deliveries, s.deliveries[i])
+		}
+	}
+	return deliveries
+}
@@ -0,0 +1,149 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Signature-256"
+	TimestampHeader = "X-Alert-Timestamp"
+	DeliveryHeader  = "X-Alert-Delivery"
+)
+
+type Event struct {
+	DeliveryID string     `json:"delivery_id"`
+	Event      Transition `json:"event"`
+	RuleID     string     `json:"rule_id"`
+	CEP        string     `json:"cep"`
+	Metric     string     `json:"metric"`
+	Operator   Operator   `json:"operator"`
+	Threshold  float64    `json:"threshold"`
+	Value      float64    `json:"value"`
+	ObservedAt int64      `json:"observed_at"`
+	SentAt     time.Time  `json:"sent_at"`
+}
+
+// Sign returns the hex HMAC-SHA256 of "timestamp.body", sent as
+// "sha256=<hex>" in the X-Signature-256 header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature header produced by Sign; receivers should also
+// reject timestamps that are too old.
+func Verify(secret string, timestamp int64, body []byte, header string) bool {
+	expected := "sha256=" + Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(header))
+}
+
+type Deliverer struct {
+	Client      *http.Client
+	MaxAttempts int
+	Backoff     time.Duration
+}
+
+// NewDeliverer returns a Deliverer whose client refuses to connect to
+// private addresses and ignores proxy settings, so the check applies to
+// the webhook host itself.
+func NewDeliverer() *Deliverer {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: dialControl}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		MaxIdleConns:        10,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 5 * time.Second,
+	}
+	return &Deliverer{
+		Client:      &http.Client{Timeout: 10 * time.Second, Transport: transport},
+		MaxAttempts: 3,
+		Backoff:     time.Second,
+	}
+}
+
+// Deliver posts the event to the rule's webhook, retrying with exponential
+// backoff on transport errors and non-2xx responses. Rules without a
+// secret are never posted, so every webhook is signed.
+func (d *Deliverer) Deliver(ctx context.Context, rule Rule, event Event) Delivery {
+	delivery := Delivery{
+		ID:        event.DeliveryID,
+		RuleID:    rule.ID,
+		Event:     event.Event,
+		URL:       rule.WebhookURL,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if rule.Secret == "" {
+		delivery.Error = "secret is required"
+		return delivery
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+
+	backoff := d.Backoff
+	for attempt := 1; attempt <= d.MaxAttempts; attempt++ {
+		delivery.Attempts = attempt
+		status, err := d.post(ctx, rule.WebhookURL, rule.Secret, event.DeliveryID, body)
+		delivery.StatusCode = status
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			return delivery
+		}
+		delivery.Error = err.Error()
+
+		if attempt == d.MaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			delivery.Error = ctx.Err().Error()
+			return delivery
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return delivery
+}
+
+func (d *Deliverer) post(ctx context.Context, url, secret, deliveryID string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(DeliveryHeader, deliveryID)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(secret, timestamp, body))
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("status code: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,158 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookReceiver é um receptor local que valida a assinatura HMAC e pode
+// falhar as primeiras tentativas.
+type webhookReceiver struct {
+	t        *testing.T
+	secret   string
+	failures int
+
+	mu       sync.Mutex
+	attempts int
+	events   []Event
+}
+
+func (rcv *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	rcv.attempts++
+
+	body, _ := io.ReadAll(r.Body)
+	timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+	if !Verify(rcv.secret, timestamp, body, r.Header.Get(SignatureHeader)) {
+		rcv.t.Errorf("Invalid signature %q", r.Header.Get(SignatureHeader))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if rcv.attempts <= rcv.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var event Event
+	json.Unmarshal(body, &event)
+	rcv.events = append(rcv.events, event)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (rcv *webhookReceiver) received() []Event {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	return append([]Event(nil), rcv.events...)
+}
+
+// allowPrivateWebhooks libera os receptores locais do httptest durante o teste
+func allowPrivateWebhooks(t *testing.T) {
+	t.Helper()
+	AllowPrivateWebhooks = true
+	t.Cleanup(func() { AllowPrivateWebhooks = false })
+}
+
+func newTestDeliverer() *Deliverer {
+	deliverer := NewDeliverer()
+	deliverer.Backoff = time.Millisecond
+	return deliverer
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"triggered"}`)
+	header := "sha256=" + Sign("secret", 1700000000, body)
+
+	if !Verify("secret", 1700000000, body, header) {
+		t.Error("Expected signature to verify")
+	}
+	if Verify("other", 1700000000, body, header) {
+		t.Error("Expected signature with another secret to fail")
+	}
+	if Verify("secret", 1700000001, body, header) {
+		t.Error("Expected signature with another timestamp to fail")
+	}
+}
+
+func TestDeliverer_RetriesUntilSuccess(t *testing.T) {
+	allowPrivateWebhooks(t)
+	receiver := &webhookReceiver{t: t, secret: "rule-secret", failures: 2}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	rule := Rule{ID: "r1", WebhookURL: server.URL, Secret: "rule-secret"}
+	delivery := newTestDeliverer().Deliver(context.Background(), rule, Event{DeliveryID: "d1", Event: Triggered, RuleID: "r1"})
+
+	if !delivery.Success {
+		t.Fatalf("Expected delivery to succeed, got %+v", delivery)
+	}
+	if delivery.Attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", delivery.Attempts)
+	}
+	if delivery.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", delivery.StatusCode)
+	}
+}
+
+func TestDeliverer_GivesUpAfterMaxAttempts(t *testing.T) {
+	allowPrivateWebhooks(t)
+	receiver := &webhookReceiver{t: t, secret: "global", failures: 10}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	rule := Rule{ID: "r1", WebhookURL: server.URL, Secret: "global"}
+	delivery := newTestDeliverer().Deliver(context.Background(), rule, Event{DeliveryID: "d1"})
+
+	if delivery.Success {
+		t.Fatal("Expected delivery to fail")
+	}
+	if delivery.Attempts != 3 || delivery.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected 3 attempts ending in 503, got %+v", delivery)
+	}
+	if delivery.Error == "" {
+		t.Error("Expected delivery error to be recorded")
+	}
+}
+
+func TestDeliverer_RequiresSecret(t *testing.T) {
+	allowPrivateWebhooks(t)
+	receiver := &webhookReceiver{t: t}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	// Sem secret nada é enviado, para não haver webhooks sem assinatura
+	rule := Rule{ID: "r1", WebhookURL: server.URL}
+	delivery := newTestDeliverer().Deliver(context.Background(), rule, Event{DeliveryID: "d1"})
+	if delivery.Success || delivery.Attempts != 0 || delivery.Error != "secret is required" {
+		t.Errorf("Expected delivery to be refused, got %+v", delivery)
+	}
+	if events := receiver.received(); len(events) != 0 {
+		t.Errorf("Expected no webhook, got %d", len(events))
+	}
+}
+
+func TestDeliverer_RefusesPrivateAddress(t *testing.T) {
+	receiver := &webhookReceiver{t: t, secret: "global"}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	// A conexão é recusada mesmo para regras que não passaram pela validação,
+	// como um nome que passou a resolver para 127.0.0.1
+	rule := Rule{ID: "r1", WebhookURL: server.URL, Secret: "global"}
+	delivery := newTestDeliverer().Deliver(context.Background(), rule, Event{DeliveryID: "d1"})
+	if delivery.Success || !strings.Contains(delivery.Error, ErrPrivateAddress.Error()) {
+		t.Errorf("Expected private address error, got %+v", delivery)
+	}
+	if events := receiver.received(); len(events) != 0 {
+		t.Errorf("Expected no webhook, got %d", len(events))
+	}
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type CreateKeyResponse struct {
+	Key
+	Secret string `json:"secret"`
+}
+
+type KeyDetailsResponse struct {
+	Key
+	Usage []UsageEntry `json:"usage"`
+}
+
+type Handler struct {
+	Store *Store
+}
+
+func NewHandler(store *Store) *Handler {
+	return &Handler{Store: store}
+}
+
+// Register mounts the key administration API on mux. Middleware only lets
+// admin keys reach it:
+//
+//	POST   /admin/keys
+//	GET    /admin/keys
+//	GET    /admin/keys/{id}
+//	DELETE /admin/keys/{id}
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /admin/keys", h.createKey)
+	mux.HandleFunc("GET /admin/keys", h.listKeys)
+	mux.HandleFunc("GET /admin/keys/{id}", h.getKey)
+	mux.HandleFunc("DELETE /admin/keys/{id}", h.revokeKey)
+}
+
+func (h *Handler) createKey(w http.ResponseWriter, r *http.Request) {
+	var req CreateKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key, secret, err := h.Store.Create(req, time.Now())
+	if err != nil {
+		writeError(w, r, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	w.Header().Set("Location", "/admin/keys/"+key.ID)
+	writeJSON(w, http.StatusCreated, CreateKeyResponse{Key: key, Secret: secret})
+}
+
+func (h *Handler) listKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.Store.List())
+}
+
+func (h *Handler) getKey(w http.ResponseWriter, r *http.Request) {
+	key, err := h.Store.Get(r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	usage, _ := h.Store.Usage(key.ID)
+	writeJSON(w, http.StatusOK, KeyDetailsResponse{Key: key, Usage: usage})
+}
+
+func (h *Handler) revokeKey(w http.ResponseWriter, r *http.Request) {
+	key, err := h.Store.Revoke(r.PathValue("id"), time.Now())
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, key)
+}
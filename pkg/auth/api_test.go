@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const adminSecret = "admin-secret-for-tests"
+
+func newTestAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+	store := NewStore(60, 1000)
+	if _, err := store.Import(CreateKeyRequest{Name: "admin", Admin: true}, adminSecret, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(newProtectedMux(t, store))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func do(t *testing.T, method, url, secret, body string) *http.Response {
+	t.Helper()
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+secret)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestAPI_CreateUseInspectRevoke(t *testing.T) {
+	server := newTestAPI(t)
+
+	resp := do(t, http.MethodPost, server.URL+"/admin/keys", adminSecret, `{"name":"mobile app","daily_quota":5}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", resp.StatusCode)
+	}
+	var created CreateKeyResponse
+	json.NewDecoder(resp.Body).Decode(&created)
+	if created.Secret == "" || created.DailyQuota != 5 || created.RateLimit != 60 {
+		t.Fatalf("Unexpected key: %+v", created)
+	}
+	if resp.Header.Get("Location") != "/admin/keys/"+created.ID {
+		t.Errorf("Unexpected Location %q", resp.Header.Get("Location"))
+	}
+
+	useResp := do(t, http.MethodGet, server.URL+"/temperature", created.Secret, "")
+	if useResp.StatusCode != http.StatusOK || useResp.Header.Get("X-Quota-Remaining") != "4" {
+		t.Fatalf("Unexpected response: %d %v", useResp.StatusCode, useResp.Header)
+	}
+
+	getResp := do(t, http.MethodGet, server.URL+"/admin/keys/"+created.ID, adminSecret, "")
+	var raw map[string]interface{}
+	json.NewDecoder(getResp.Body).Decode(&raw)
+	if _, ok := raw["secret"]; ok {
+		t.Error("Secret must only be returned on creation")
+	}
+	usage, _ := raw["usage"].([]interface{})
+	if len(usage) != 1 {
+		t.Errorf("Expected one usage entry, got %v", raw["usage"])
+	}
+
+	listResp := do(t, http.MethodGet, server.URL+"/admin/keys", adminSecret, "")
+	var keys []Key
+	json.NewDecoder(listResp.Body).Decode(&keys)
+	if len(keys) != 2 {
+		t.Errorf("Expected 2 keys, got %d", len(keys))
+	}
+
+	revokeResp := do(t, http.MethodDelete, server.URL+"/admin/keys/"+created.ID, adminSecret, "")
+	if revokeResp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", revokeResp.StatusCode)
+	}
+	if resp := do(t, http.MethodGet, server.URL+"/temperature", created.Secret, ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected revoked key to be rejected, got %d", resp.StatusCode)
+	}
+}
+
+func TestAPI_Errors(t *testing.T) {
+	server := newTestAPI(t)
+
+	if resp := do(t, http.MethodPost, server.URL+"/admin/keys", adminSecret, `{`); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+	if resp := do(t, http.MethodPost, server.URL+"/admin/keys", adminSecret, `{"name":""}`); resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status 422, got %d", resp.StatusCode)
+	}
+	if resp := do(t, http.MethodGet, server.URL+"/admin/keys/missing", adminSecret, ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+}
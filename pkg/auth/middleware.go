@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+type contextKey struct{}
+
+// FromContext returns the key that authenticated the request.
+func FromContext(ctx context.Context) (Key, bool) {
+	key, ok := ctx.Value(contextKey{}).(Key)
+	return key, ok
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	lang := i18n.FromRequest(r)
+	w.Header().Set("Content-Language", lang)
+	writeJSON(w, status, weather.ErrorResponse{Error: i18n.Message(lang, message)})
+}
+
+// secretFromRequest reads X-API-Key or an Authorization bearer token.
+func secretFromRequest(r *http.Request) string {
+	if secret := r.Header.Get("X-API-Key"); secret != "" {
+		return secret
+	}
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if found && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+func setLimitHeaders(h http.Header, d Decision) {
+	if d.RateLimit > 0 {
+		h.Set("X-RateLimit-Limit", strconv.Itoa(d.RateLimit))
+		h.Set("X-RateLimit-Remaining", strconv.Itoa(d.RateRemaining))
+		h.Set("X-RateLimit-Reset", strconv.FormatInt(d.RateReset.Unix(), 10))
+	}
+	if d.Quota > 0 {
+		h.Set("X-Quota-Limit", strconv.Itoa(d.Quota))
+		h.Set("X-Quota-Remaining", strconv.Itoa(d.QuotaRemaining))
+		h.Set("X-Quota-Reset", strconv.FormatInt(d.QuotaReset.Unix(), 10))
+	}
+}
+
+// Middleware rejects requests without a valid key (401), over the key's
+// rate limit or daily quota (429), or under /admin/ without an admin key
+// (403). Served requests are recorded per key and route pattern.
+func Middleware(store *Store) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			secret := secretFromRequest(r)
+			if secret == "" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="temperature_server"`)
+				writeError(w, r, http.StatusUnauthorized, "missing api key")
+				return
+			}
+
+			now := time.Now()
+			key, decision, err := store.Consume(secret, now)
+			switch {
+			case errors.Is(err, ErrInvalidKey), errors.Is(err, ErrRevokedKey):
+				w.Header().Set("WWW-Authenticate", `Bearer realm="temperature_server", error="invalid_token"`)
+				writeError(w, r, http.StatusUnauthorized, err.Error())
+				return
+			case errors.Is(err, ErrRateLimited), errors.Is(err, ErrQuotaExceeded):
+				setLimitHeaders(w.Header(), decision)
+				reset := decision.RateReset
+				if errors.Is(err, ErrQuotaExceeded) {
+					reset = decision.QuotaReset
+				}
+				w.Header().Set("Retry-After", strconv.Itoa(int(reset.Sub(now).Seconds())+1))
+				writeError(w, r, http.StatusTooManyRequests, err.Error())
+				return
+			}
+
+			if strings.HasPrefix(r.URL.Path, "/admin/") && !key.Admin {
+				writeError(w, r, http.StatusForbidden, "admin key required")
+				return
+			}
+
+			setLimitHeaders(w.Header(), decision)
+			r = r.WithContext(context.WithValue(r.Context(), contextKey{}, key))
+			next.ServeHTTP(w, r)
+
+			// ServeMux fills in Pattern while routing; unmatched requests
+			// are grouped together.
+			endpoint := r.Pattern
+			if endpoint == "" {
+				endpoint = "unmatched"
+			}
+			store.RecordUsage(key.ID, endpoint, now)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+func newProtectedMux(t *testing.T, store *Store) http.Handler {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /temperature", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := FromContext(r.Context()); !ok {
+			t.Error("Expected the key in the request context")
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	NewHandler(store).Register(mux)
+	return Middleware(store)(mux)
+}
+
+func serve(handler http.Handler, method, target string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_Authentication(t *testing.T) {
+	store := NewStore(0, 0)
+	key, secret, _ := store.Create(CreateKeyRequest{Name: "client"}, time.Now())
+	revokedKey, revokedSecret, _ := store.Create(CreateKeyRequest{Name: "old"}, time.Now())
+	store.Revoke(revokedKey.ID, time.Now())
+	handler := newProtectedMux(t, store)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		status int
+	}{
+		{"missing", http.Header{}, http.StatusUnauthorized},
+		{"invalid", http.Header{"X-Api-Key": {"tsk_wrong"}}, http.StatusUnauthorized},
+		{"revoked", http.Header{"X-Api-Key": {revokedSecret}}, http.StatusUnauthorized},
+		{"header", http.Header{"X-Api-Key": {secret}}, http.StatusOK},
+		{"bearer", http.Header{"Authorization": {"Bearer " + secret}}, http.StatusOK},
+		{"basic", http.Header{"Authorization": {"Basic " + secret}}, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(handler, http.MethodGet, "/temperature?cep=35630016", tt.header)
+			if rec.Code != tt.status {
+				t.Fatalf("Expected status %d, got %d", tt.status, rec.Code)
+			}
+			if tt.status == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("Expected a WWW-Authenticate header")
+			}
+		})
+	}
+
+	usage, _ := store.Usage(key.ID)
+	if len(usage) != 1 || usage[0].Endpoint != "GET /temperature" || usage[0].Requests != 2 {
+		t.Errorf("Unexpected usage: %+v", usage)
+	}
+}
+
+func TestMiddleware_RateLimit(t *testing.T) {
+	store := NewStore(1, 0)
+	_, secret, _ := store.Create(CreateKeyRequest{Name: "client"}, time.Now())
+	handler := newProtectedMux(t, store)
+	header := http.Header{"X-Api-Key": {secret}}
+
+	rec := serve(handler, http.MethodGet, "/temperature", header)
+	if rec.Code != http.StatusOK || rec.Header().Get("X-RateLimit-Remaining") != "0" {
+		t.Fatalf("Unexpected first response: %d %v", rec.Code, rec.Header())
+	}
+
+	rec = serve(handler, http.MethodGet, "/temperature", header)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("Expected a Retry-After header")
+	}
+}
+
+func TestMiddleware_Localized(t *testing.T) {
+	handler := newProtectedMux(t, NewStore(0, 0))
+	rec := serve(handler, http.MethodGet, "/temperature", http.Header{"Accept-Language": {"pt-BR"}})
+
+	var body weather.ErrorResponse
+	json.NewDecoder(rec.Body).Decode(&body)
+	if body.Error != "chave de API ausente" {
+		t.Errorf("Expected a localized error, got %q", body.Error)
+	}
+}
+
+func TestMiddleware_AdminRoutes(t *testing.T) {
+	store := NewStore(0, 0)
+	_, secret, _ := store.Create(CreateKeyRequest{Name: "client"}, time.Now())
+	handler := newProtectedMux(t, store)
+
+	rec := serve(handler, http.MethodGet, "/admin/keys", http.Header{"X-Api-Key": {secret}})
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", rec.Code)
+	}
+}
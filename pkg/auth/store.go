@@ -0,0 +1,286 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	secretPrefix = "tsk_"
+	// usageRetention is how many days of usage are kept per key.
+	usageRetention = 31
+	dayLayout      = "2006-01-02"
+)
+
+var (
+	ErrInvalidKey    = errors.New("invalid api key")
+	ErrRevokedKey    = errors.New("api key revoked")
+	ErrKeyNotFound   = errors.New("key not found")
+	ErrRateLimited   = errors.New("rate limit exceeded")
+	ErrQuotaExceeded = errors.New("daily quota exceeded")
+)
+
+// Key is an API client. The secret itself is never stored, only its
+// SHA-256, and Prefix identifies it in listings.
+type Key struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Admin      bool       `json:"admin"`
+	RateLimit  int        `json:"rate_limit"`
+	DailyQuota int        `json:"daily_quota"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type UsageEntry struct {
+	Date     string `json:"date"`
+	Endpoint string `json:"endpoint"`
+	Requests int    `json:"requests"`
+}
+
+// Decision describes the limits left after an accepted request.
+type Decision struct {
+	RateLimit      int
+	RateRemaining  int
+	RateReset      time.Time
+	Quota          int
+	QuotaRemaining int
+	QuotaReset     time.Time
+}
+
+type keyState struct {
+	key         Key
+	windowStart time.Time
+	windowCount int
+	day         string
+	dayCount    int
+	// usage is date → endpoint → requests.
+	usage map[string]map[string]int
+}
+
+// Store keeps keys, limit counters and usage in memory. Limits of zero
+// disable the corresponding check.
+type Store struct {
+	DefaultRateLimit  int
+	DefaultDailyQuota int
+
+	mu     sync.Mutex
+	keys   map[string]*keyState
+	byHash map[string]string
+}
+
+func NewStore(defaultRateLimit, defaultDailyQuota int) *Store {
+	return &Store{
+		DefaultRateLimit:  defaultRateLimit,
+		DefaultDailyQuota: defaultDailyQuota,
+		keys:              map[string]*keyState{},
+		byHash:            map[string]string{},
+	}
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func NewSecret() string {
+	return secretPrefix + randomHex(24)
+}
+
+type CreateKeyRequest struct {
+	Name       string `json:"name"`
+	Admin      bool   `json:"admin"`
+	RateLimit  *int   `json:"rate_limit,omitempty"`
+	DailyQuota *int   `json:"daily_quota,omitempty"`
+}
+
+// Create issues a new key and returns it with its secret, which is not
+// retrievable afterwards.
+func (s *Store) Create(req CreateKeyRequest, now time.Time) (Key, string, error) {
+	secret := NewSecret()
+	key, err := s.Import(req, secret, now)
+	return key, secret, err
+}
+
+// Import registers a key for a secret chosen by the caller, such as the
+// bootstrap admin key from configuration.
+func (s *Store) Import(req CreateKeyRequest, secret string, now time.Time) (Key, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return Key{}, errors.New("name is required")
+	}
+	if len(secret) < 16 {
+		return Key{}, errors.New("secret must have at least 16 characters")
+	}
+	if (req.RateLimit != nil && *req.RateLimit < 0) || (req.DailyQuota != nil && *req.DailyQuota < 0) {
+		return Key{}, errors.New("limits must not be negative")
+	}
+
+	key := Key{
+		ID:         randomHex(8),
+		Name:       req.Name,
+		Prefix:     secret[:min(len(secret), len(secretPrefix)+6)],
+		Admin:      req.Admin,
+		RateLimit:  s.DefaultRateLimit,
+		DailyQuota: s.DefaultDailyQuota,
+		CreatedAt:  now.UTC(),
+	}
+	if req.RateLimit != nil {
+		key.RateLimit = *req.RateLimit
+	}
+	if req.DailyQuota != nil {
+		key.DailyQuota = *req.DailyQuota
+	}
+
+	hash := hashSecret(secret)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.byHash[hash]; exists {
+		return Key{}, errors.New("secret already registered")
+	}
+	s.keys[key.ID] = &keyState{key: key, usage: map[string]map[string]int{}}
+	s.byHash[hash] = key.ID
+	return key, nil
+}
+
+func (s *Store) Get(id string) (Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.keys[id]
+	if !ok {
+		return Key{}, ErrKeyNotFound
+	}
+	return state.key, nil
+}
+
+func (s *Store) List() []Key {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]Key, 0, len(s.keys))
+	for _, state := range s.keys {
+		keys = append(keys, state.key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+	return keys
+}
+
+// Revoke disables a key; it stays listed with its usage.
+func (s *Store) Revoke(id string, now time.Time) (Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.keys[id]
+	if !ok {
+		return Key{}, ErrKeyNotFound
+	}
+	if state.key.RevokedAt == nil {
+		revoked := now.UTC()
+		state.key.RevokedAt = &revoked
+	}
+	return state.key, nil
+}
+
+// Consume authenticates secret and charges one request against its rate
+// limit (per minute) and daily quota (per UTC day).
+func (s *Store) Consume(secret string, now time.Time) (Key, Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, ok := s.byHash[hashSecret(secret)]
+	if !ok {
+		return Key{}, Decision{}, ErrInvalidKey
+	}
+	state := s.keys[id]
+	if state.key.RevokedAt != nil {
+		return state.key, Decision{}, ErrRevokedKey
+	}
+
+	now = now.UTC()
+	minute := now.Truncate(time.Minute)
+	if !state.windowStart.Equal(minute) {
+		state.windowStart, state.windowCount = minute, 0
+	}
+	day := now.Format(dayLayout)
+	if state.day != day {
+		state.day, state.dayCount = day, 0
+	}
+
+	decision := Decision{
+		RateLimit:  state.key.RateLimit,
+		RateReset:  minute.Add(time.Minute),
+		Quota:      state.key.DailyQuota,
+		QuotaReset: now.Truncate(24 * time.Hour).Add(24 * time.Hour),
+	}
+	if state.key.RateLimit > 0 && state.windowCount >= state.key.RateLimit {
+		return state.key, decision, ErrRateLimited
+	}
+	if state.key.DailyQuota > 0 && state.dayCount >= state.key.DailyQuota {
+		return state.key, decision, ErrQuotaExceeded
+	}
+
+	state.windowCount++
+	state.dayCount++
+	state.key.LastUsedAt = &now
+	decision.RateRemaining = max(state.key.RateLimit-state.windowCount, 0)
+	decision.QuotaRemaining = max(state.key.DailyQuota-state.dayCount, 0)
+	return state.key, decision, nil
+}
+
+// RecordUsage counts a served request for the key under endpoint, keeping
+// the last usageRetention days.
+func (s *Store) RecordUsage(id, endpoint string, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.keys[id]
+	if !ok {
+		return
+	}
+	day := now.UTC().Format(dayLayout)
+	if state.usage[day] == nil {
+		state.usage[day] = map[string]int{}
+		oldest := now.UTC().AddDate(0, 0, -usageRetention).Format(dayLayout)
+		for d := range state.usage {
+			if d <= oldest {
+				delete(state.usage, d)
+			}
+		}
+	}
+	state.usage[day][endpoint]++
+}
+
+func (s *Store) Usage(id string) ([]UsageEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.keys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	entries := []UsageEntry{}
+	for day, endpoints := range state.usage {
+		for endpoint, requests := range endpoints {
+			entries = append(entries, UsageEntry{Date: day, Endpoint: endpoint, Requests: requests})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Date != entries[j].Date {
+			return entries[i].Date > entries[j].Date
+		}
+		return entries[i].Endpoint < entries[j].Endpoint
+	})
+	return entries, nil
+}
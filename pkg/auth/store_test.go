@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestCreateAndConsume(t *testing.T) {
+	store := NewStore(60, 1000)
+	now := time.Date(2025, 3, 10, 12, 0, 30, 0, time.UTC)
+
+	key, secret, err := store.Create(CreateKeyRequest{Name: "client"}, now)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !strings.HasPrefix(secret, "tsk_") || !strings.HasPrefix(secret, key.Prefix) {
+		t.Errorf("Unexpected secret %q for prefix %q", secret, key.Prefix)
+	}
+	if key.RateLimit != 60 || key.DailyQuota != 1000 {
+		t.Errorf("Expected default limits, got %+v", key)
+	}
+
+	got, decision, err := store.Consume(secret, now)
+	if err != nil {
+		t.Fatalf("Consume failed: %v", err)
+	}
+	if got.ID != key.ID || got.LastUsedAt == nil {
+		t.Errorf("Unexpected key: %+v", got)
+	}
+	if decision.RateRemaining != 59 || decision.QuotaRemaining != 999 {
+		t.Errorf("Unexpected decision: %+v", decision)
+	}
+	if want := time.Date(2025, 3, 10, 12, 1, 0, 0, time.UTC); !decision.RateReset.Equal(want) {
+		t.Errorf("Expected rate reset at %v, got %v", want, decision.RateReset)
+	}
+	if want := time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC); !decision.QuotaReset.Equal(want) {
+		t.Errorf("Expected quota reset at %v, got %v", want, decision.QuotaReset)
+	}
+
+	if _, _, err := store.Consume("tsk_unknown", now); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Expected ErrInvalidKey, got %v", err)
+	}
+}
+
+func TestConsumeRateLimit(t *testing.T) {
+	store := NewStore(2, 0)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	_, secret, _ := store.Create(CreateKeyRequest{Name: "client"}, now)
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := store.Consume(secret, now); err != nil {
+			t.Fatalf("Request %d: %v", i, err)
+		}
+	}
+	if _, _, err := store.Consume(secret, now.Add(59*time.Second)); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+	if _, _, err := store.Consume(secret, now.Add(time.Minute)); err != nil {
+		t.Errorf("Expected a new window to accept the request, got %v", err)
+	}
+}
+
+func TestConsumeDailyQuota(t *testing.T) {
+	store := NewStore(0, 100)
+	now := time.Date(2025, 3, 10, 23, 0, 0, 0, time.UTC)
+	_, secret, _ := store.Create(CreateKeyRequest{Name: "client", DailyQuota: intPtr(1)}, now)
+
+	if _, _, err := store.Consume(secret, now); err != nil {
+		t.Fatalf("Consume failed: %v", err)
+	}
+	if _, _, err := store.Consume(secret, now.Add(time.Hour-time.Second)); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Expected ErrQuotaExceeded, got %v", err)
+	}
+	if _, _, err := store.Consume(secret, now.Add(time.Hour)); err != nil {
+		t.Errorf("Expected the quota to reset at midnight UTC, got %v", err)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	store := NewStore(0, 0)
+	now := time.Now()
+	key, secret, _ := store.Create(CreateKeyRequest{Name: "client"}, now)
+
+	revoked, err := store.Revoke(key.ID, now)
+	if err != nil || revoked.RevokedAt == nil {
+		t.Fatalf("Revoke failed: %+v %v", revoked, err)
+	}
+	if _, _, err := store.Consume(secret, now); !errors.Is(err, ErrRevokedKey) {
+		t.Errorf("Expected ErrRevokedKey, got %v", err)
+	}
+	if _, err := store.Revoke("missing", now); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected ErrKeyNotFound, got %v", err)
+	}
+}
+
+func TestImportValidation(t *testing.T) {
+	store := NewStore(0, 0)
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		req    CreateKeyRequest
+		secret string
+	}{
+		{"empty name", CreateKeyRequest{Name: " "}, "0123456789abcdef"},
+		{"short secret", CreateKeyRequest{Name: "admin"}, "short"},
+		{"negative limit", CreateKeyRequest{Name: "admin", RateLimit: intPtr(-1)}, "0123456789abcdef"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := store.Import(tt.req, tt.secret, now); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+
+	if _, err := store.Import(CreateKeyRequest{Name: "admin"}, "0123456789abcdef", now); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if _, err := store.Import(CreateKeyRequest{Name: "again"}, "0123456789abcdef", now); err == nil {
+		t.Error("Expected duplicate secrets to be rejected")
+	}
+}
+
+func TestRecordUsage(t *testing.T) {
+	store := NewStore(0, 0)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	key, _, _ := store.Create(CreateKeyRequest{Name: "client"}, now)
+
+	store.RecordUsage(key.ID, "/temperature", now.AddDate(0, 0, -40))
+	store.RecordUsage(key.ID, "/temperature", now)
+	store.RecordUsage(key.ID, "/temperature", now)
+	store.RecordUsage(key.ID, "/weather", now)
+
+	usage, err := store.Usage(key.ID)
+	if err != nil {
+		t.Fatalf("Usage failed: %v", err)
+	}
+	want := []UsageEntry{
+		{Date: "2025-03-10", Endpoint: "/temperature", Requests: 2},
+		{Date: "2025-03-10", Endpoint: "/weather", Requests: 1},
+	}
+	if len(usage) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, usage)
+	}
+	for i := range want {
+		if usage[i] != want[i] {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, want[i], usage[i])
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package calculations
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	julianUnixEpoch = 2440587.5
+	julian2000      = 2451545.0
+	synodicMonth    = 29.530588853
+	// knownNewMoon is the Julian day of the new moon of 2000-01-06 18:14 UTC.
+	knownNewMoon = 2451550.26
+)
+
+type SunEvents struct {
+	Sunrise   time.Time
+	Sunset    time.Time
+	SolarNoon time.Time
+	// PolarDay and PolarNight are set when the sun does not cross the
+	// horizon; Sunrise and Sunset are then zero.
+	PolarDay   bool
+	PolarNight bool
+}
+
+func toJulian(t time.Time) float64 {
+	return float64(t.Unix())/86400 + julianUnixEpoch
+}
+
+func fromJulian(jd float64) time.Time {
+	seconds := (jd - julianUnixEpoch) * 86400
+	return time.Unix(int64(math.Round(seconds)), 0).UTC()
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// SunTimes solves the sunrise equation for the calendar day of date at
+// lat/lon (east positive), including the -0.833° correction for refraction
+// and the solar disc. Results are in UTC and good to about a minute.
+func SunTimes(date time.Time, lat, lon float64) SunEvents {
+	noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, time.UTC)
+	n := math.Round(toJulian(noon) - julian2000 + 0.0008)
+	meanSolarNoon := n - lon/360
+
+	m := math.Mod(357.5291+0.98560028*meanSolarNoon, 360)
+	c := 1.9148*math.Sin(radians(m)) + 0.0200*math.Sin(radians(2*m)) + 0.0003*math.Sin(radians(3*m))
+	lambda := math.Mod(m+c+180+102.9372, 360)
+	transit := julian2000 + meanSolarNoon + 0.0053*math.Sin(radians(m)) - 0.0069*math.Sin(radians(2*lambda))
+
+	sinDeclination := math.Sin(radians(lambda)) * math.Sin(radians(23.4397))
+	cosDeclination := math.Cos(math.Asin(sinDeclination))
+	cosHourAngle := (math.Sin(radians(-0.833)) - math.Sin(radians(lat))*sinDeclination) /
+		(math.Cos(radians(lat)) * cosDeclination)
+
+	events := SunEvents{SolarNoon: fromJulian(transit)}
+	switch {
+	case cosHourAngle < -1:
+		events.PolarDay = true
+	case cosHourAngle > 1:
+		events.PolarNight = true
+	default:
+		hourAngle := degrees(math.Acos(cosHourAngle))
+		events.Sunrise = fromJulian(transit - hourAngle/360)
+		events.Sunset = fromJulian(transit + hourAngle/360)
+	}
+	return events
+}
+
+var moonPhases = []string{
+	"New Moon",
+	"Waxing Crescent",
+	"First Quarter",
+	"Waxing Gibbous",
+	"Full Moon",
+	"Waning Gibbous",
+	"Last Quarter",
+	"Waning Crescent",
+}
+
+// MoonPhase estimates the phase name (as WeatherAPI spells it) and the
+// illuminated percentage at t from the mean synodic month.
+func MoonPhase(t time.Time) (string, float64) {
+	age := math.Mod(toJulian(t)-knownNewMoon, synodicMonth)
+	if age < 0 {
+		age += synodicMonth
+	}
+
+	fraction := age / synodicMonth
+	illumination := (1 - math.Cos(2*math.Pi*fraction)) / 2 * 100
+	phase := moonPhases[int(math.Floor(fraction*8+0.5))%8]
+	return phase, math.Round(illumination)
+}
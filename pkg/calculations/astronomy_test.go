@@ -0,0 +1,62 @@
+package calculations
+
+import (
+	"testing"
+	"time"
+)
+
+func within(t *testing.T, name string, got, expected time.Time, tolerance time.Duration) {
+	t.Helper()
+	diff := got.Sub(expected)
+	if diff < -tolerance || diff > tolerance {
+		t.Errorf("%s: expected %v ± %v, got %v", name, expected, tolerance, got)
+	}
+}
+
+func TestSunTimes(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// Valores publicados pela NOAA para Londres no solstício de 2024.
+	events := SunTimes(time.Date(2024, 6, 21, 0, 0, 0, 0, london), 51.5074, -0.1278)
+	within(t, "sunrise", events.Sunrise, time.Date(2024, 6, 21, 4, 43, 0, 0, london), 2*time.Minute)
+	within(t, "sunset", events.Sunset, time.Date(2024, 6, 21, 21, 21, 0, 0, london), 2*time.Minute)
+	within(t, "solar noon", events.SolarNoon, time.Date(2024, 6, 21, 13, 2, 0, 0, london), 2*time.Minute)
+}
+
+func TestSunTimesPolar(t *testing.T) {
+	// Longyearbyen, Svalbard.
+	summer := SunTimes(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), 78.22, 15.65)
+	if !summer.PolarDay || !summer.Sunrise.IsZero() {
+		t.Errorf("Expected polar day, got %+v", summer)
+	}
+
+	winter := SunTimes(time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), 78.22, 15.65)
+	if !winter.PolarNight || !winter.Sunset.IsZero() {
+		t.Errorf("Expected polar night, got %+v", winter)
+	}
+}
+
+func TestMoonPhase(t *testing.T) {
+	tests := []struct {
+		at           time.Time
+		phase        string
+		illumination float64
+	}{
+		{time.Date(2025, 7, 10, 20, 37, 0, 0, time.UTC), "Full Moon", 100},
+		{time.Date(2025, 7, 24, 19, 11, 0, 0, time.UTC), "New Moon", 0},
+		{time.Date(2025, 7, 18, 0, 38, 0, 0, time.UTC), "Last Quarter", 50},
+	}
+
+	for _, test := range tests {
+		phase, illumination := MoonPhase(test.at)
+		if phase != test.phase {
+			t.Errorf("%v: expected %s, got %s", test.at, test.phase, phase)
+		}
+		if diff := illumination - test.illumination; diff < -5 || diff > 5 {
+			t.Errorf("%v: expected illumination %.0f%%, got %.0f%%", test.at, test.illumination, illumination)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package calculations
+
+import (
+	"math"
+	"temperature_server/pkg/utils"
+)
+
+type Conditions struct {
+	TempC    float64
+	Humidity float64
+	WindKph  float64
+}
+
+// HeatIndex uses the NWS Rothfusz regression, falling back to Steadman's
+// simple formula when the result is below 80°F as the NWS recommends.
+func HeatIndex(tempC, humidity float64) float64 {
+	t := utils.FromCelsius(tempC).Fahrenheit()
+	rh := humidity
+
+	simple := 0.5 * (t + 61.0 + (t-68.0)*1.2 + rh*0.094)
+	if (simple+t)/2 < 80 {
+		return utils.NewTemperature(simple, utils.Fahrenheit).Celsius()
+	}
+
+	hi := -42.379 +
+		2.04901523*t +
+		10.14333127*rh -
+		0.22475541*t*rh -
+		0.00683783*t*t -
+		0.05481717*rh*rh +
+		0.00122874*t*t*rh +
+		0.00085282*t*rh*rh -
+		0.00000199*t*t*rh*rh
+
+	switch {
+	case rh < 13 && t >= 80 && t <= 112:
+		hi -= ((13 - rh) / 4) * math.Sqrt((17-math.Abs(t-95))/17)
+	case rh > 85 && t >= 80 && t <= 87:
+		hi += ((rh - 85) / 10) * ((87 - t) / 5)
+	}
+
+	return utils.NewTemperature(hi, utils.Fahrenheit).Celsius()
+}
+
+// WindChill uses the 2001 JAG/TI formula. Outside its validity range
+// (above 10°C or wind below 4.8 km/h) the air temperature is returned.
+func WindChill(tempC, windKph float64) float64 {
+	if tempC > 10 || windKph <= 4.8 {
+		return tempC
+	}
+	v := math.Pow(windKph, 0.16)
+	return 13.12 + 0.6215*tempC - 11.37*v + 0.3965*tempC*v
+}
+
+// DewPoint uses the Magnus formula with the Alduchov-Eskridge constants.
+func DewPoint(tempC, humidity float64) float64 {
+	const a, b = 17.625, 243.04
+	if humidity <= 0 {
+		humidity = 0.01
+	}
+	gamma := math.Log(humidity/100) + a*tempC/(b+tempC)
+	return b * gamma / (a - gamma)
+}
+
+func Humidex(tempC, humidity float64) float64 {
+	dew := utils.FromCelsius(DewPoint(tempC, humidity)).Kelvin()
+	e := 6.11 * math.Exp(5417.7530*(1/273.16-1/dew))
+	return tempC + 0.5555*(e-10)
+}
+
+// vapourPressure returns the water vapour pressure in hPa.
+func vapourPressure(tempC, humidity float64) float64 {
+	return humidity / 100 * 6.105 * math.Exp(17.27*tempC/(237.7+tempC))
+}
+
+// ApparentTemperature is Steadman's non-radiant formula as used by the
+// Australian Bureau of Meteorology.
+func ApparentTemperature(tempC, humidity, windKph float64) float64 {
+	ws := windKph / 3.6
+	return tempC + 0.33*vapourPressure(tempC, humidity) - 0.70*ws - 4.00
+}
+
+// WBGT is the Australian Bureau of Meteorology approximation for shade,
+// which ignores solar radiation and wind.
+func WBGT(tempC, humidity float64) float64 {
+	return 0.567*tempC + 0.393*vapourPressure(tempC, humidity) + 3.94
+}
+
+type Index struct {
+	ValueC      float64  `json:"value_C"`
+	Risk        Risk     `json:"risk,omitempty"`
+	ProviderC   *float64 `json:"provider_C,omitempty"`
+	DifferenceC *float64 `json:"difference_C,omitempty"`
+}
+
+// Compare records the provider's own value for the index alongside ours.
+func (i *Index) Compare(providerC float64) {
+	diff := utils.RoundTo(i.ValueC-providerC, 2)
+	i.ProviderC = &providerC
+	i.DifferenceC = &diff
+}
+
+type Indices struct {
+	HeatIndex           Index `json:"heat_index"`
+	WindChill           Index `json:"wind_chill"`
+	DewPoint            Index `json:"dew_point"`
+	Humidex             Index `json:"humidex"`
+	ApparentTemperature Index `json:"apparent_temperature"`
+	WBGT                Index `json:"wbgt"`
+}
+
+func Compute(c Conditions) Indices {
+	heatIndex := HeatIndex(c.TempC, c.Humidity)
+	windChill := WindChill(c.TempC, c.WindKph)
+	humidex := Humidex(c.TempC, c.Humidity)
+	wbgt := WBGT(c.TempC, c.Humidity)
+
+	return Indices{
+		HeatIndex:           Index{ValueC: utils.RoundTo(heatIndex, 2), Risk: HeatIndexRisk(heatIndex)},
+		WindChill:           Index{ValueC: utils.RoundTo(windChill, 2), Risk: WindChillRisk(windChill)},
+		DewPoint:            Index{ValueC: utils.RoundTo(DewPoint(c.TempC, c.Humidity), 2)},
+		Humidex:             Index{ValueC: utils.RoundTo(humidex, 2), Risk: HumidexRisk(humidex)},
+		ApparentTemperature: Index{ValueC: utils.RoundTo(ApparentTemperature(c.TempC, c.Humidity, c.WindKph), 2)},
+		WBGT:                Index{ValueC: utils.RoundTo(wbgt, 2), Risk: WBGTRisk(wbgt)},
+	}
+}
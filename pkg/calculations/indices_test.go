@@ -0,0 +1,149 @@
+package calculations
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHeatIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		tempC    float64
+		humidity float64
+		expected float64
+	}{
+		{
+			// Tabela NWS: 90°F com 70% de umidade = 106°F
+			name:     "90°F e 70%",
+			tempC:    32.2222,
+			humidity: 70,
+			expected: 41.07,
+		},
+		{
+			name:     "abaixo de 80°F usa a fórmula simples",
+			tempC:    20,
+			humidity: 50,
+			expected: 19.36,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := HeatIndex(tt.tempC, tt.humidity)
+			if math.Abs(result-tt.expected) > 0.05 {
+				t.Errorf("HeatIndex(%f, %f) = %f, expected %f", tt.tempC, tt.humidity, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWindChill(t *testing.T) {
+	tests := []struct {
+		name     string
+		tempC    float64
+		windKph  float64
+		expected float64
+	}{
+		{name: "-10°C e 30 km/h", tempC: -10, windKph: 30, expected: -19.52},
+		{name: "acima de 10°C retorna a temperatura", tempC: 15, windKph: 30, expected: 15},
+		{name: "sem vento retorna a temperatura", tempC: -5, windKph: 2, expected: -5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := WindChill(tt.tempC, tt.windKph)
+			if math.Abs(result-tt.expected) > 0.01 {
+				t.Errorf("WindChill(%f, %f) = %f, expected %f", tt.tempC, tt.windKph, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDewPoint(t *testing.T) {
+	tests := []struct {
+		tempC    float64
+		humidity float64
+		expected float64
+	}{
+		{tempC: 25, humidity: 60, expected: 16.70},
+		{tempC: 20, humidity: 100, expected: 20},
+		{tempC: 0, humidity: 50, expected: -9.19},
+	}
+
+	for _, tt := range tests {
+		result := DewPoint(tt.tempC, tt.humidity)
+		if math.Abs(result-tt.expected) > 0.01 {
+			t.Errorf("DewPoint(%f, %f) = %f, expected %f", tt.tempC, tt.humidity, result, tt.expected)
+		}
+	}
+}
+
+func TestHumidex(t *testing.T) {
+	// Tabela de Environment Canada: 30°C com ponto de orvalho de 15°C = 34
+	humidity := 100 * math.Exp(17.625*15/(243.04+15)) / math.Exp(17.625*30/(243.04+30))
+	result := Humidex(30, humidity)
+	if math.Abs(result-34) > 0.5 {
+		t.Errorf("Humidex(30, %f) = %f, expected ~34", humidity, result)
+	}
+}
+
+func TestApparentTemperatureAndWBGT(t *testing.T) {
+	at := ApparentTemperature(30, 50, 0)
+	if math.Abs(at-32.98) > 0.01 {
+		t.Errorf("ApparentTemperature(30, 50, 0) = %f, expected ~32.98", at)
+	}
+
+	// O vento reduz a temperatura aparente
+	if windy := ApparentTemperature(30, 50, 36); windy >= at {
+		t.Errorf("Expected wind to lower apparent temperature, got %f >= %f", windy, at)
+	}
+
+	wbgt := WBGT(30, 50)
+	if math.Abs(wbgt-29.26) > 0.01 {
+		t.Errorf("WBGT(30, 50) = %f, expected ~29.26", wbgt)
+	}
+}
+
+func TestRiskCategories(t *testing.T) {
+	tests := []struct {
+		name     string
+		risk     Risk
+		expected Risk
+	}{
+		{name: "heat index ameno", risk: HeatIndexRisk(25), expected: RiskNone},
+		{name: "heat index cautela", risk: HeatIndexRisk(28), expected: RiskCaution},
+		{name: "heat index perigo", risk: HeatIndexRisk(41.07), expected: RiskDanger},
+		{name: "heat index perigo extremo", risk: HeatIndexRisk(55), expected: RiskExtremeDanger},
+		{name: "wind chill baixo", risk: WindChillRisk(-5), expected: RiskLow},
+		{name: "wind chill alto", risk: WindChillRisk(-30), expected: RiskHigh},
+		{name: "humidex desconforto", risk: HumidexRisk(35), expected: RiskCaution},
+		{name: "wbgt extremo", risk: WBGTRisk(33), expected: RiskExtreme},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.risk != tt.expected {
+				t.Errorf("Expected risk %s, got %s", tt.expected, tt.risk)
+			}
+		})
+	}
+}
+
+func TestComputeAndCompare(t *testing.T) {
+	indices := Compute(Conditions{TempC: 32.2222, Humidity: 70, WindKph: 10})
+
+	if indices.HeatIndex.Risk != RiskDanger {
+		t.Errorf("Expected heat index risk %s, got %s", RiskDanger, indices.HeatIndex.Risk)
+	}
+	if indices.WindChill.ValueC != 32.22 {
+		t.Errorf("Expected wind chill to equal temperature, got %f", indices.WindChill.ValueC)
+	}
+
+	indices.HeatIndex.Compare(40)
+	if indices.HeatIndex.ProviderC == nil || *indices.HeatIndex.ProviderC != 40 {
+		t.Fatalf("Expected provider value 40, got %v", indices.HeatIndex.ProviderC)
+	}
+	if *indices.HeatIndex.DifferenceC != 1.07 {
+		t.Errorf("Expected difference 1.07, got %f", *indices.HeatIndex.DifferenceC)
+	}
+}
@@ -0,0 +1,84 @@
+package calculations
+
+import "temperature_server/pkg/utils"
+
+type Risk string
+
+const (
+	RiskNone           Risk = "none"
+	RiskCaution        Risk = "caution"
+	RiskExtremeCaution Risk = "extreme_caution"
+	RiskDanger         Risk = "danger"
+	RiskExtremeDanger  Risk = "extreme_danger"
+
+	RiskLow      Risk = "low"
+	RiskModerate Risk = "moderate"
+	RiskHigh     Risk = "high"
+	RiskVeryHigh Risk = "very_high"
+	RiskExtreme  Risk = "extreme"
+)
+
+// HeatIndexRisk follows the NWS heat index chart bands.
+func HeatIndexRisk(heatIndexC float64) Risk {
+	f := utils.FromCelsius(heatIndexC).Fahrenheit()
+	switch {
+	case f >= 125:
+		return RiskExtremeDanger
+	case f >= 103:
+		return RiskDanger
+	case f >= 90:
+		return RiskExtremeCaution
+	case f >= 80:
+		return RiskCaution
+	default:
+		return RiskNone
+	}
+}
+
+// WindChillRisk follows Environment Canada's frostbite risk bands.
+func WindChillRisk(windChillC float64) Risk {
+	switch {
+	case windChillC < -48:
+		return RiskExtreme
+	case windChillC < -40:
+		return RiskVeryHigh
+	case windChillC < -28:
+		return RiskHigh
+	case windChillC < -10:
+		return RiskModerate
+	default:
+		return RiskLow
+	}
+}
+
+// HumidexRisk follows Environment Canada's humidex comfort bands.
+func HumidexRisk(humidex float64) Risk {
+	switch {
+	case humidex >= 54:
+		return RiskExtremeDanger
+	case humidex >= 46:
+		return RiskDanger
+	case humidex >= 40:
+		return RiskExtremeCaution
+	case humidex >= 30:
+		return RiskCaution
+	default:
+		return RiskNone
+	}
+}
+
+// WBGTRisk uses the common US military heat category flags.
+func WBGTRisk(wbgtC float64) Risk {
+	switch {
+	case wbgtC >= 32.2:
+		return RiskExtreme
+	case wbgtC >= 31.1:
+		return RiskVeryHigh
+	case wbgtC >= 29.4:
+		return RiskHigh
+	case wbgtC >= 27.8:
+		return RiskModerate
+	default:
+		return RiskLow
+	}
+}
@@ -0,0 +1,171 @@
+// Package cep parses and validates Brazilian postal codes (CEPs) and maps
+// them to their state (UF) using the Correios ranges, so impossible CEPs are
+// rejected before any provider is called.
+package cep
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalid     = errors.New("invalid zipcode")
+	ErrUFMismatch  = errors.New("zipcode does not match UF")
+	errUnsupported = errors.New("unsupported CEP layout")
+)
+
+// CEP is a validated CEP: eight ASCII digits within a Correios range. The
+// zero value is not a valid CEP.
+type CEP string
+
+// ufRange is a Correios range of CEPs, by their first five digits.
+type ufRange struct {
+	first, last int
+	uf          string
+}
+
+// ranges are sorted and do not overlap; CEPs below 01000-000, and those
+// in the gaps the Correios never assigned, belong to no state.
+var ranges = []ufRange{
+	{1000, 19999, "SP"},
+	{20000, 28999, "RJ"},
+	{29000, 29999, "ES"},
+	{30000, 39999, "MG"},
+	{40000, 48999, "BA"},
+	{49000, 49999, "SE"},
+	{50000, 56999, "PE"},
+	{57000, 57999, "AL"},
+	{58000, 58999, "PB"},
+	{59000, 59999, "RN"},
+	{60000, 63999, "CE"},
+	{64000, 64999, "PI"},
+	{65000, 65999, "MA"},
+	{66000, 68899, "PA"},
+	{68900, 68999, "AP"},
+	{69000, 69299, "AM"},
+	{69300, 69399, "RR"},
+	{69400, 69899, "AM"},
+	{69900, 69999, "AC"},
+	{70000, 72799, "DF"},
+	{72800, 72999, "GO"},
+	{73000, 73699, "DF"},
+	{73700, 76799, "GO"},
+	{76800, 76999, "RO"},
+	{77000, 77999, "TO"},
+	{78000, 78899, "MT"},
+	{79000, 79999, "MS"},
+	{80000, 87999, "PR"},
+	{88000, 89999, "SC"},
+	{90000, 99999, "RS"},
+}
+
+// Parse accepts a CEP as 35630016, 35630-016 or 35.630-016 (35630.016 and
+// 35630 016 too, which ViaCEP always took), with optional surrounding
+// spaces. Anything else, including non-ASCII digits and CEPs outside every
+// Correios range, is ErrInvalid.
+func Parse(s string) (CEP, error) {
+	digits, err := digitsOf(strings.TrimSpace(s))
+	if err != nil {
+		return "", ErrInvalid
+	}
+	c := CEP(digits)
+	if c.UF() == "" {
+		return "", ErrInvalid
+	}
+	return c, nil
+}
+
+// digitsOf strips the separators of the accepted layouts.
+func digitsOf(s string) (string, error) {
+	switch {
+	case len(s) == 8:
+	case len(s) == 9 && strings.IndexByte("-. ", s[5]) >= 0:
+		s = s[:5] + s[6:]
+	case len(s) == 10 && s[2] == '.' && s[6] == '-':
+		s = s[:2] + s[3:6] + s[7:]
+	default:
+		return "", errUnsupported
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return "", errUnsupported
+		}
+	}
+	return s, nil
+}
+
+// MustParse is Parse for constants; it panics on invalid input.
+func MustParse(s string) CEP {
+	c, err := Parse(s)
+	if err != nil {
+		panic("cep: " + err.Error() + ": " + s)
+	}
+	return c
+}
+
+// Valid reports whether s parses.
+func Valid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// Digits returns the CEP as eight digits, as ViaCEP expects it.
+func (c CEP) Digits() string {
+	return string(c)
+}
+
+// String formats the CEP as 35630-016.
+func (c CEP) String() string {
+	if len(c) != 8 {
+		return string(c)
+	}
+	return string(c[:5]) + "-" + string(c[5:])
+}
+
+// prefix is the value of the first five digits, or -1 when c is not eight
+// digits.
+func (c CEP) prefix() int {
+	if len(c) != 8 {
+		return -1
+	}
+	n := 0
+	for i := 0; i < 5; i++ {
+		if c[i] < '0' || c[i] > '9' {
+			return -1
+		}
+		n = n*10 + int(c[i]-'0')
+	}
+	return n
+}
+
+// UF returns the state c belongs to, or "" when it is outside every range.
+func (c CEP) UF() string {
+	p := c.prefix()
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].last >= p })
+	if p < 0 || i == len(ranges) || p < ranges[i].first {
+		return ""
+	}
+	return ranges[i].uf
+}
+
+// CheckUF cross-checks the UF a provider returned for c.
+func (c CEP) CheckUF(uf string) error {
+	if !strings.EqualFold(strings.TrimSpace(uf), c.UF()) {
+		return ErrUFMismatch
+	}
+	return nil
+}
+
+func (c CEP) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *CEP) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
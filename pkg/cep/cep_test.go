@@ -0,0 +1,174 @@
+package cep
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input  string
+		digits string
+		uf     string
+	}{
+		{"35630016", "35630016", "MG"},
+		{"35630-016", "35630016", "MG"},
+		{"35.630-016", "35630016", "MG"},
+		{"35630.016", "35630016", "MG"},
+		{"35630 016", "35630016", "MG"},
+		{" 01001000 ", "01001000", "SP"},
+		{"20040-020", "20040020", "RJ"},
+		{"69900-000", "69900000", "AC"},
+		{"70040-010", "70040010", "DF"},
+		{"72800-000", "72800000", "GO"},
+		{"99999-999", "99999999", "RS"},
+	}
+	for _, tt := range tests {
+		c, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.input, err)
+			continue
+		}
+		if c.Digits() != tt.digits || c.UF() != tt.uf {
+			t.Errorf("Parse(%q) = %s (%s), expected %s (%s)", tt.input, c.Digits(), c.UF(), tt.digits, tt.uf)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"123",
+		"356300160",
+		"3563001a",
+		"abc-def",
+		"3563-0016",
+		"356-30016",
+		"35-630.016",
+		"35.630.016",
+		"35630--016",
+		"+35630016",
+		"٣٥٦٣٠٠١٦", // dígitos árabe-índicos
+		"３５６３００１６", // dígitos de largura total
+		// Fora de qualquer faixa dos Correios
+		"00000000",
+		"00999-999",
+		"78950-000", // entre MT e MS
+	} {
+		if _, err := Parse(input); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q): expected ErrInvalid, got %v", input, err)
+		}
+		if Valid(input) {
+			t.Errorf("Valid(%q) = true", input)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if s := MustParse("35.630-016").String(); s != "35630-016" {
+		t.Errorf("expected 35630-016, got %s", s)
+	}
+}
+
+func TestRangesAreSortedAndDisjoint(t *testing.T) {
+	for i, r := range ranges {
+		if r.first > r.last {
+			t.Errorf("%s: range %05d-%05d is empty", r.uf, r.first, r.last)
+		}
+		if i > 0 && r.first <= ranges[i-1].last {
+			t.Errorf("%s: range %05d-%05d overlaps %s", r.uf, r.first, r.last, ranges[i-1].uf)
+		}
+	}
+}
+
+func TestCheckUF(t *testing.T) {
+	c := MustParse("35630-016")
+	for _, uf := range []string{"MG", "mg", " MG "} {
+		if err := c.CheckUF(uf); err != nil {
+			t.Errorf("CheckUF(%q): %v", uf, err)
+		}
+	}
+	for _, uf := range []string{"SP", ""} {
+		if err := c.CheckUF(uf); !errors.Is(err, ErrUFMismatch) {
+			t.Errorf("CheckUF(%q): expected ErrUFMismatch, got %v", uf, err)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var body struct {
+		CEP CEP `json:"cep"`
+	}
+	if err := json.Unmarshal([]byte(`{"cep": "35630016"}`), &body); err != nil || body.CEP != "35630016" {
+		t.Fatalf("unexpected %q, %v", body.CEP, err)
+	}
+	encoded, _ := json.Marshal(body)
+	if string(encoded) != `{"cep":"35630-016"}` {
+		t.Errorf("unexpected %s", encoded)
+	}
+	if err := json.Unmarshal([]byte(`{"cep": "00000000"}`), &body); err == nil {
+		t.Error("expected error for 00000000")
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{"35630016", "35630-016", "35.630-016", " 01001000 ", "00000000", "3563001a", "٣٥٦٣٠٠١٦", ""} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		c, err := Parse(input)
+		if err != nil {
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Parse(%q): unexpected error %v", input, err)
+			}
+			return
+		}
+		digits := c.Digits()
+		if len(digits) != 8 {
+			t.Fatalf("Parse(%q) = %q, expected 8 digits", input, digits)
+		}
+		for i := 0; i < len(digits); i++ {
+			if digits[i] < '0' || digits[i] > '9' {
+				t.Fatalf("Parse(%q) = %q, expected ASCII digits", input, digits)
+			}
+		}
+		if c.UF() == "" {
+			t.Fatalf("Parse(%q) = %q without UF", input, digits)
+		}
+		// Os formatos gerados voltam ao mesmo CEP
+		for _, formatted := range []string{c.String(), c.Digits()} {
+			if again, err := Parse(formatted); err != nil || again != c {
+				t.Fatalf("Parse(%q) = %q, %v, expected %q", formatted, again, err, c)
+			}
+		}
+	})
+}
+
+func FuzzUF(f *testing.F) {
+	f.Add(uint32(35630016))
+	f.Add(uint32(0))
+	f.Add(uint32(99999999))
+	f.Add(uint32(78950000))
+	f.Fuzz(func(t *testing.T, n uint32) {
+		n %= 100000000
+		prefix := int(n / 1000)
+		digits := []byte("00000000")
+		for i := 7; i >= 0; i-- {
+			digits[i] = byte('0' + n%10)
+			n /= 10
+		}
+		c, err := Parse(string(digits))
+		// Só os CEPs fora de todas as faixas ficam sem UF
+		inRange := false
+		for _, r := range ranges {
+			inRange = inRange || (r.first <= prefix && prefix <= r.last)
+		}
+		if (err == nil) != inRange {
+			t.Fatalf("Parse(%s): %v", digits, err)
+		}
+		if err == nil && c.CheckUF(c.UF()) != nil {
+			t.Fatalf("%s does not match its own UF", digits)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"temperature_server/pkg/alerts"
+	"temperature_server/pkg/openapi"
+	"temperature_server/pkg/weather"
+	"testing"
+)
+
+// newTestServer serve a API de regras de alerta e um /temperature fixo
+// atrás do middleware de validação OpenAPI.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	api, err := openapi.NewHandler()
+	if err != nil {
+		t.Fatalf("openapi.NewHandler() error: %v", err)
+	}
+	mux := http.NewServeMux()
+	alerts.NewHandler(alerts.NewStore()).Register(mux)
+	mux.HandleFunc("/temperature", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(weather.TemperatureResponse{Temp_C: 25, Temp_F: 77, Temp_K: 298})
+	})
+	server := httptest.NewServer(api.Middleware(mux))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetTemperature(t *testing.T) {
+	server := newTestServer(t)
+	c, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("NewClientWithResponses() error: %v", err)
+	}
+
+	resp, err := c.GetTemperatureWithResponse(context.Background(), &GetTemperatureParams{Cep: "35630-016"})
+	if err != nil {
+		t.Fatalf("GetTemperature() error: %v", err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("expected a 200 body, got status %d", resp.StatusCode())
+	}
+	temperature, err := resp.JSON200.AsTemperatureResponse()
+	if err != nil {
+		t.Fatalf("AsTemperatureResponse() error: %v", err)
+	}
+	if temperature.TempC != 25 || temperature.TempF != 77 || temperature.TempK != 298 {
+		t.Errorf("unexpected temperature: %+v", temperature)
+	}
+}
+
+func TestGetTemperature_InvalidCEP(t *testing.T) {
+	server := newTestServer(t)
+	c, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("NewClientWithResponses() error: %v", err)
+	}
+
+	resp, err := c.GetTemperatureWithResponse(context.Background(), &GetTemperatureParams{Cep: "abc"})
+	if err != nil {
+		t.Fatalf("GetTemperature() error: %v", err)
+	}
+	if resp.JSON400 == nil {
+		t.Fatalf("expected a 400 body, got status %d", resp.StatusCode())
+	}
+	if resp.JSON400.Error != "invalid parameter: cep" {
+		t.Errorf("unexpected error: %q", resp.JSON400.Error)
+	}
+}
+
+func TestAlertRules(t *testing.T) {
+	server := newTestServer(t)
+	c, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("NewClientWithResponses() error: %v", err)
+	}
+	ctx := context.Background()
+
+	secret := "s3cr3t"
+	created, err := c.CreateAlertRuleWithResponse(ctx, CreateRuleRequest{
+		Cep:        "35630-016",
+		Metric:     "temp_c",
+		Operator:   CreateRuleRequestOperatorLessThan,
+		Threshold:  2,
+		WebhookUrl: "https://example.com/hook",
+		Secret:     &secret,
+	})
+	if err != nil {
+		t.Fatalf("CreateAlertRule() error: %v", err)
+	}
+	if created.JSON201 == nil {
+		t.Fatalf("expected a 201 body, got status %d: %s", created.StatusCode(), created.Body)
+	}
+	if created.JSON201.State != RuleStateOk || created.JSON201.Operator != RuleOperatorLessThan {
+		t.Errorf("unexpected rule: %+v", created.JSON201)
+	}
+
+	fetched, err := c.GetAlertRuleWithResponse(ctx, created.JSON201.Id)
+	if err != nil {
+		t.Fatalf("GetAlertRule() error: %v", err)
+	}
+	if fetched.JSON200 == nil || fetched.JSON200.Id != created.JSON201.Id {
+		t.Fatalf("expected rule %s, got status %d", created.JSON201.Id, fetched.StatusCode())
+	}
+
+	missing, err := c.GetAlertRuleWithResponse(ctx, "missing")
+	if err != nil {
+		t.Fatalf("GetAlertRule() error: %v", err)
+	}
+	if missing.JSON404 == nil {
+		t.Errorf("expected a 404 body, got status %d", missing.StatusCode())
+	}
+}
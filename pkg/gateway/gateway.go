@@ -0,0 +1,129 @@
+// Package gateway is the edge service run by cmd/gateway. It validates CEPs
+// posted by clients and forwards valid ones to the temperature service's
+// /v1/temperature, answering with the city and its temperatures.
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"temperature_server/pkg/cep"
+	"temperature_server/pkg/middleware"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/tracing"
+	v1 "temperature_server/pkg/v1"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+// ServiceTimeout bounds each call to the temperature service.
+var ServiceTimeout = 10 * time.Second
+
+type TemperatureRequest struct {
+	CEP string `json:"cep"`
+}
+
+type CityTemperatureResponse struct {
+	City string `json:"city"`
+	weather.TemperatureResponse
+}
+
+// envelope is the part of the temperature service's v1.Response the
+// gateway reads.
+type envelope struct {
+	Data     weather.TemperatureResponse `json:"data"`
+	Location v1.Location                 `json:"location"`
+}
+
+var errUnavailable = errors.New("temperature service unavailable")
+
+type Handler struct {
+	// ServiceURL is the temperature service's base URL.
+	ServiceURL string
+	// APIKey is sent as X-API-Key when the service requires keys.
+	APIKey string
+	Client *http.Client
+}
+
+func NewHandler(serviceURL, apiKey string) *Handler {
+	return &Handler{
+		ServiceURL: serviceURL,
+		APIKey:     apiKey,
+		Client:     &http.Client{Transport: tracing.Transport(http.DefaultTransport), Timeout: ServiceTimeout},
+	}
+}
+
+// Register mounts the gateway on mux:
+//
+//	POST /temperature
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /temperature", h.temperature)
+}
+
+// validCEP accepts exactly eight digits in a Correios range; formatting is
+// the client's job at the edge.
+func validCEP(code string) bool {
+	parsed, err := cep.Parse(code)
+	return err == nil && parsed.Digits() == code
+}
+
+func (h *Handler) temperature(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		CEP *string `json:"cep"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.CEP == nil || !validCEP(*req.CEP) {
+		weather.WriteError(w, r, render.JSON, http.StatusUnprocessableEntity, "invalid zipcode")
+		return
+	}
+
+	response, err := h.forward(r, *req.CEP)
+	if err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusBadGateway, errUnavailable.Error())
+		return
+	}
+	defer response.Body.Close()
+
+	// Errors the service answers with are passed on with their status
+	if response.StatusCode != http.StatusOK {
+		var serviceErr weather.ErrorResponse
+		if err := json.NewDecoder(response.Body).Decode(&serviceErr); err != nil || serviceErr.Error == "" {
+			weather.WriteError(w, r, render.JSON, http.StatusBadGateway, errUnavailable.Error())
+			return
+		}
+		for _, name := range []string{"Retry-After", "Content-Language"} {
+			if value := response.Header.Get(name); value != "" {
+				w.Header().Set(name, value)
+			}
+		}
+		render.Write(w, render.JSON, response.StatusCode, serviceErr)
+		return
+	}
+
+	var data envelope
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusBadGateway, errUnavailable.Error())
+		return
+	}
+	render.Write(w, render.JSON, http.StatusOK, CityTemperatureResponse{City: data.Location.City, TemperatureResponse: data.Data})
+}
+
+// forward asks the temperature service for cep, in the trace and with the
+// request ID and language of r.
+func (h *Handler) forward(r *http.Request, cep string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, h.ServiceURL+"/v1/temperature?cep="+url.QueryEscape(cep), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if lang := r.Header.Get("Accept-Language"); lang != "" {
+		req.Header.Set("Accept-Language", lang)
+	}
+	if id := middleware.RequestIDFromContext(r.Context()); id != "" {
+		req.Header.Set(middleware.RequestIDHeader, id)
+	}
+	if h.APIKey != "" {
+		req.Header.Set("X-API-Key", h.APIKey)
+	}
+	return h.Client.Do(req)
+}
@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"temperature_server/pkg/weather"
+	"testing"
+)
+
+// newTestGateway aponta o gateway para service e devolve o mux com a rota.
+func newTestGateway(serviceURL string) *http.ServeMux {
+	mux := http.NewServeMux()
+	NewHandler(serviceURL, "gateway-key").Register(mux)
+	return mux
+}
+
+func post(mux *http.ServeMux, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/temperature", strings.NewReader(body)))
+	return rec
+}
+
+func TestInvalidCEP(t *testing.T) {
+	calls := 0
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { calls++ }))
+	defer service.Close()
+	mux := newTestGateway(service.URL)
+
+	for _, body := range []string{
+		`{"cep": "3563001"}`,
+		`{"cep": "356300160"}`,
+		`{"cep": "35630-016"}`,
+		`{"cep": "3563001a"}`,
+		`{"cep": "00000000"}`,
+		`{"cep": 35630016}`,
+		`{}`,
+		`cep=35630016`,
+	} {
+		rec := post(mux, body)
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("%s: expected status 422, got %d", body, rec.Code)
+		}
+		var response weather.ErrorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil || response.Error != "invalid zipcode" {
+			t.Errorf("%s: expected invalid zipcode, got %s", body, rec.Body.String())
+		}
+	}
+	// Entradas inválidas não chegam ao serviço de temperatura
+	if calls != 0 {
+		t.Errorf("expected no calls to the service, got %d", calls)
+	}
+}
+
+func TestForward(t *testing.T) {
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/temperature" || r.URL.Query().Get("cep") != "35630016" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if r.Header.Get("X-API-Key") != "gateway-key" {
+			t.Errorf("expected the service key, got %q", r.Header.Get("X-API-Key"))
+		}
+		w.Write([]byte(`{"data":{"temp_C":25,"temp_F":77,"temp_K":298.15},` +
+			`"location":{"cep":"35630-016","city":"Bom Despacho","uf":"MG"},` +
+			`"provider":"weatherapi","observed_at":"2025-07-10T00:15:00Z",` +
+			`"cache":{"status":"miss","expires_at":"2025-07-10T00:30:00Z"}}`))
+	}))
+	defer service.Close()
+
+	rec := post(newTestGateway(service.URL), `{"cep": "35630016"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	expected := `{"city":"Bom Despacho","temp_C":25,"temp_F":77,"temp_K":298.15}`
+	if got := strings.TrimSpace(rec.Body.String()); got != expected {
+		t.Errorf("unexpected body:\n got %s\nwant %s", got, expected)
+	}
+}
+
+func TestForwardServiceError(t *testing.T) {
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":"upstream rate limit exceeded"}`))
+	}))
+	defer service.Close()
+
+	// O status e a mensagem do serviço são repassados
+	rec := post(newTestGateway(service.URL), `{"cep": "35630016"}`)
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "30" {
+		t.Errorf("expected 429 with Retry-After, got %d %q", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	if !strings.Contains(rec.Body.String(), "upstream rate limit exceeded") {
+		t.Errorf("unexpected body: %s", rec.Body.String())
+	}
+}
+
+func TestServiceUnavailable(t *testing.T) {
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>bad gateway</html>"))
+	}))
+	mux := newTestGateway(service.URL)
+
+	rec := post(mux, `{"cep": "35630016"}`)
+	if rec.Code != http.StatusBadGateway || !strings.Contains(rec.Body.String(), "temperature service unavailable") {
+		t.Errorf("expected 502 for an unexpected answer, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	// Serviço fora do ar
+	service.Close()
+	rec = post(mux, `{"cep": "35630016"}`)
+	if rec.Code != http.StatusBadGateway {
+		t.Errorf("expected 502 when the service is down, got %d", rec.Code)
+	}
+}
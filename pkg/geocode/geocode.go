@@ -0,0 +1,139 @@
+// Package geocode answers reverse geocoding queries: the municipality, and a
+// representative CEP of it, closest to a pair of coordinates. It searches an
+// embedded table of municipality centroids (municipalities.csv) with a k-d
+// tree, so no provider is called.
+package geocode
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"temperature_server/pkg/cep"
+)
+
+//go:embed municipalities.csv
+var municipalitiesCSV string
+
+// Bounding box of Brazil, oceanic islands included. Coordinates outside it
+// have no municipality, however close the nearest centroid is.
+const (
+	minLat, maxLat = -33.76, 5.28
+	minLon, maxLon = -74.0, -28.8
+)
+
+// MaxDistanceKm is the farthest a point may be from the nearest centroid.
+// The bounding box also covers parts of the neighbouring countries, such
+// as Asunción, about 300 km from Foz do Iguaçu.
+const MaxDistanceKm = 200
+
+var ErrOutside = errors.New("no municipality near location")
+
+type Municipality struct {
+	// IBGE is the municipality's 7-digit IBGE code.
+	IBGE string
+	Name string
+	UF   string
+	// CEP is a CEP of the municipality's center.
+	CEP cep.CEP
+	// Lat and Lon are the centroid.
+	Lat, Lon float64
+}
+
+type Index struct {
+	tree kdTree
+}
+
+// Load reads a municipality table: a header line, then
+//
+//	ibge,name,uf,lat,lon,cep
+//
+// per municipality. Every CEP must belong to its municipality's UF.
+func Load(r io.Reader) (*Index, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 6
+	if _, err := reader.Read(); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	var entries []entry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		m, err := parseMunicipality(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry{p: toPoint(m.Lat, m.Lon), m: m})
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no municipalities")
+	}
+	return &Index{tree: newKDTree(entries)}, nil
+}
+
+func parseMunicipality(record []string) (Municipality, error) {
+	m := Municipality{IBGE: record[0], Name: strings.TrimSpace(record[1]), UF: record[2]}
+	if len(m.IBGE) != 7 || strings.Trim(m.IBGE, "0123456789") != "" {
+		return Municipality{}, fmt.Errorf("invalid IBGE code %q", m.IBGE)
+	}
+	lat, latErr := strconv.ParseFloat(record[3], 64)
+	lon, lonErr := strconv.ParseFloat(record[4], 64)
+	if latErr != nil || lonErr != nil || !InBrazil(lat, lon) {
+		return Municipality{}, fmt.Errorf("invalid coordinates %s,%s", record[3], record[4])
+	}
+	m.Lat, m.Lon = lat, lon
+	code, err := cep.Parse(record[5])
+	if err != nil {
+		return Municipality{}, fmt.Errorf("%w %q", err, record[5])
+	}
+	if err := code.CheckUF(m.UF); err != nil {
+		return Municipality{}, fmt.Errorf("%w: %s is not in %s", err, code, m.UF)
+	}
+	m.CEP = code
+	return m, nil
+}
+
+// Embedded is the index of municipalities.csv, built on first use.
+var Embedded = sync.OnceValue(func() *Index {
+	index, err := Load(strings.NewReader(municipalitiesCSV))
+	if err != nil {
+		panic("geocode: municipalities.csv: " + err.Error())
+	}
+	return index
+})
+
+// InBrazil reports whether lat, lon is within Brazil's bounding box.
+func InBrazil(lat, lon float64) bool {
+	return lat >= minLat && lat <= maxLat && lon >= minLon && lon <= maxLon
+}
+
+// Nearest returns the municipality whose centroid is closest to lat, lon
+// and its distance in kilometers, or ErrOutside for coordinates outside
+// Brazil or farther than MaxDistanceKm from every centroid.
+func (i *Index) Nearest(lat, lon float64) (Municipality, float64, error) {
+	if !InBrazil(lat, lon) {
+		return Municipality{}, 0, ErrOutside
+	}
+	found, c2 := i.tree.nearest(toPoint(lat, lon))
+	distance := chordKm(c2)
+	if distance > MaxDistanceKm {
+		return Municipality{}, 0, ErrOutside
+	}
+	return found.m, distance, nil
+}
+
+// Len is the number of municipalities in the index.
+func (i *Index) Len() int {
+	return len(i.tree)
+}
@@ -0,0 +1,153 @@
+package geocode
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// ufCodes são os dois primeiros dígitos dos códigos IBGE de cada UF
+var ufCodes = map[string]string{
+	"RO": "11", "AC": "12", "AM": "13", "RR": "14", "PA": "15", "AP": "16", "TO": "17",
+	"MA": "21", "PI": "22", "CE": "23", "RN": "24", "PB": "25", "PE": "26", "AL": "27", "SE": "28", "BA": "29",
+	"MG": "31", "ES": "32", "RJ": "33", "SP": "35",
+	"PR": "41", "SC": "42", "RS": "43",
+	"MS": "50", "MT": "51", "GO": "52", "DF": "53",
+}
+
+// ibgeCheckDigit calcula o dígito verificador (módulo 10, pesos 1 e 2)
+func ibgeCheckDigit(code string) byte {
+	sum := 0
+	for i := 0; i < 6; i++ {
+		product := int(code[i]-'0') * (1 + i%2)
+		sum += product/10 + product%10
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat, dLon := (lat2-lat1)*rad, (lon2-lon1)*rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+func TestEmbedded(t *testing.T) {
+	index := Embedded()
+	if index.Len() < 27 {
+		t.Fatalf("expected at least the 27 state capitals, got %d", index.Len())
+	}
+	seen := map[string]bool{}
+	for _, e := range index.tree {
+		m := e.m
+		if seen[m.IBGE] {
+			t.Errorf("duplicate IBGE code %s", m.IBGE)
+		}
+		seen[m.IBGE] = true
+		if ufCodes[m.UF] != m.IBGE[:2] {
+			t.Errorf("%s (%s): IBGE code does not belong to %s", m.Name, m.IBGE, m.UF)
+		}
+		if ibgeCheckDigit(m.IBGE) != m.IBGE[6] {
+			t.Errorf("%s (%s): invalid check digit", m.Name, m.IBGE)
+		}
+	}
+	// Todas as UFs têm ao menos um município
+	for uf := range ufCodes {
+		found := false
+		for _, e := range index.tree {
+			found = found || e.m.UF == uf
+		}
+		if !found {
+			t.Errorf("no municipality in %s", uf)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	header := "ibge,name,uf,lat,lon,cep\n"
+	for name, input := range map[string]string{
+		"vazio":                 "",
+		"sem municípios":        header,
+		"código IBGE inválido":  header + "310740,Bom Despacho,MG,-19.7386,-45.2522,35630-016\n",
+		"coordenadas inválidas": header + "3107406,Bom Despacho,MG,-19.7386,abc,35630-016\n",
+		"fora do Brasil":        header + "3107406,Bom Despacho,MG,40.7,-74.0,35630-016\n",
+		"CEP inválido":          header + "3107406,Bom Despacho,MG,-19.7386,-45.2522,00000000\n",
+		"CEP de outra UF":       header + "3107406,Bom Despacho,SP,-19.7386,-45.2522,35630-016\n",
+		"colunas faltando":      header + "3107406,Bom Despacho,MG,-19.7386,-45.2522\n",
+	} {
+		if _, err := Load(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	tests := []struct {
+		lat, lon float64
+		ibge     string
+	}{
+		{-19.72, -45.25, "3107406"},     // Bom Despacho
+		{-19.16, -45.44, "3100203"},     // Abaeté
+		{-23.5614, -46.6559, "3550308"}, // Avenida Paulista
+		{-22.9519, -43.2105, "3304557"}, // Cristo Redentor
+		{-15.7998, -47.8645, "5300108"}, // Esplanada dos Ministérios
+		{-18.9113, -48.2622, "3170206"}, // Uberlândia
+	}
+	index := Embedded()
+	for _, tt := range tests {
+		found, distance, err := index.Nearest(tt.lat, tt.lon)
+		if err != nil || found.IBGE != tt.ibge {
+			t.Errorf("Nearest(%v, %v) = %s (%s), %v, expected %s", tt.lat, tt.lon, found.Name, found.IBGE, err, tt.ibge)
+			continue
+		}
+		if expected := haversineKm(tt.lat, tt.lon, found.Lat, found.Lon); math.Abs(distance-expected) > 0.01 {
+			t.Errorf("Nearest(%v, %v): distance %.3f, expected %.3f", tt.lat, tt.lon, distance, expected)
+		}
+	}
+}
+
+func TestNearestOutside(t *testing.T) {
+	// Assunção fica dentro do retângulo do Brasil, mas a mais de MaxDistanceKm
+	// de qualquer centroide
+	for _, c := range [][2]float64{{40.7128, -74.0060}, {-34.6037, -58.3816}, {0, 0}, {math.NaN(), -45}, {-25.2637, -57.5759}} {
+		if _, _, err := Embedded().Nearest(c[0], c[1]); err != ErrOutside {
+			t.Errorf("Nearest(%v, %v): expected ErrOutside, got %v", c[0], c[1], err)
+		}
+	}
+}
+
+// A árvore deve concordar com a busca linear em pontos aleatórios, inclusive
+// quanto ao limite de distância
+func TestNearestMatchesLinearSearch(t *testing.T) {
+	index := Embedded()
+	random := rand.New(rand.NewSource(1))
+	matched := 0
+	for i := 0; i < 5000; i++ {
+		lat := minLat + random.Float64()*(maxLat-minLat)
+		lon := minLon + random.Float64()*(maxLon-minLon)
+
+		var expected Municipality
+		best := math.Inf(1)
+		for _, e := range index.tree {
+			if d := haversineKm(lat, lon, e.m.Lat, e.m.Lon); d < best {
+				expected, best = e.m, d
+			}
+		}
+
+		found, distance, err := index.Nearest(lat, lon)
+		if best > MaxDistanceKm {
+			if err != ErrOutside {
+				t.Fatalf("Nearest(%v, %v) = %s at %.6f km, %v; expected ErrOutside", lat, lon, found.Name, distance, err)
+			}
+			continue
+		}
+		if err != nil || math.Abs(distance-best) > 1e-6 {
+			t.Fatalf("Nearest(%v, %v) = %s at %.6f km, %v; expected %s at %.6f km", lat, lon, found.Name, distance, err, expected.Name, best)
+		}
+		matched++
+	}
+	if matched < 1000 {
+		t.Errorf("expected at least 1000 of 5000 points near a municipality, got %d", matched)
+	}
+}
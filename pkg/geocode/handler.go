@@ -0,0 +1,82 @@
+package geocode
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/weather"
+)
+
+type ReverseResponse struct {
+	IBGE string `json:"ibge"`
+	City string `json:"city"`
+	UF   string `json:"uf"`
+	CEP  string `json:"cep"`
+	// Lat and Lon are the municipality's centroid, DistanceKm how far the
+	// queried coordinates are from it.
+	Lat        float64 `json:"lat"`
+	Lon        float64 `json:"lon"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+type Handler struct {
+	Index *Index
+}
+
+func NewHandler(index *Index) *Handler {
+	return &Handler{Index: index}
+}
+
+// Register mounts the endpoint on mux:
+//
+//	GET /reverse
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /reverse", h.reverse)
+}
+
+// parseCoordinate accepts a finite decimal within ±limit.
+func parseCoordinate(value string, limit float64) (float64, bool) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(f) || math.Abs(f) > limit {
+		return 0, false
+	}
+	return f, true
+}
+
+func (h *Handler) reverse(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept")
+	w.Header().Add("Vary", "Accept-Language")
+	format, err := render.Negotiate(r)
+	if err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusNotAcceptable, "not acceptable")
+		return
+	}
+
+	query := r.URL.Query()
+	lat, latOK := parseCoordinate(query.Get("lat"), 90)
+	lon, lonOK := parseCoordinate(query.Get("lon"), 180)
+	if !latOK || !lonOK {
+		weather.WriteError(w, r, format, http.StatusBadRequest, "invalid coordinates")
+		return
+	}
+
+	found, distance, err := h.Index.Nearest(lat, lon)
+	if err != nil {
+		weather.WriteError(w, r, format, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// The answer only depends on the query, and the table only changes
+	// with a new release.
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	render.Write(w, format, http.StatusOK, ReverseResponse{
+		IBGE:       found.IBGE,
+		City:       found.Name,
+		UF:         found.UF,
+		CEP:        found.CEP.String(),
+		Lat:        found.Lat,
+		Lon:        found.Lon,
+		DistanceKm: math.Round(distance*10) / 10,
+	})
+}
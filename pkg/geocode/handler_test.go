@@ -0,0 +1,78 @@
+package geocode
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"temperature_server/pkg/weather"
+	"testing"
+)
+
+func serve(url string, header http.Header) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	NewHandler(Embedded()).Register(mux)
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestReverse(t *testing.T) {
+	rec := serve("/reverse?lat=-19.72&lon=-45.25", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response ReverseResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	expected := ReverseResponse{IBGE: "3107406", City: "Bom Despacho", UF: "MG", CEP: "35630-016", Lat: -19.7386, Lon: -45.2522, DistanceKm: 2.1}
+	if response != expected {
+		t.Errorf("expected %+v, got %+v", expected, response)
+	}
+	if rec.Header().Get("Cache-Control") == "" {
+		t.Error("expected Cache-Control")
+	}
+}
+
+func TestReverseFormats(t *testing.T) {
+	rec := serve("/reverse?lat=-19.72&lon=-45.25&format=csv", nil)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "3107406") {
+		t.Errorf("unexpected CSV response %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestReverseErrors(t *testing.T) {
+	tests := []struct {
+		query  string
+		status int
+		error  string
+	}{
+		{"", http.StatusBadRequest, "invalid coordinates"},
+		{"lat=-19.72", http.StatusBadRequest, "invalid coordinates"},
+		{"lat=abc&lon=-45.25", http.StatusBadRequest, "invalid coordinates"},
+		{"lat=NaN&lon=-45.25", http.StatusBadRequest, "invalid coordinates"},
+		{"lat=91&lon=-45.25", http.StatusBadRequest, "invalid coordinates"},
+		{"lat=-19.72&lon=-181", http.StatusBadRequest, "invalid coordinates"},
+		{"lat=40.7128&lon=-74.0060", http.StatusNotFound, "no municipality near location"},
+		{"lat=-25.2637&lon=-57.5759", http.StatusNotFound, "no municipality near location"},
+	}
+	for _, tt := range tests {
+		rec := serve("/reverse?"+tt.query, nil)
+		var response weather.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if rec.Code != tt.status || response.Error != tt.error {
+			t.Errorf("%q: expected %d %q, got %d %s", tt.query, tt.status, tt.error, rec.Code, rec.Body.String())
+		}
+	}
+
+	// Mensagens traduzidas conforme Accept-Language
+	rec := serve("/reverse?lat=40.7128&lon=-74.0060", http.Header{"Accept-Language": {"pt-BR"}})
+	if !strings.Contains(rec.Body.String(), "nenhum município próximo ao local") {
+		t.Errorf("expected translated error, got %s", rec.Body.String())
+	}
+}
@@ -0,0 +1,86 @@
+package geocode
+
+import (
+	"math"
+	"sort"
+)
+
+// earthRadiusKm is the mean Earth radius.
+const earthRadiusKm = 6371.0
+
+// point is a position on the unit sphere. Straight-line (chord) distances
+// between points grow with great-circle distances, so the nearest point in
+// a plain 3-d tree is also the nearest on the globe, with no special cases
+// at the antimeridian or the poles.
+type point [3]float64
+
+func toPoint(lat, lon float64) point {
+	phi, lambda := lat*math.Pi/180, lon*math.Pi/180
+	return point{math.Cos(phi) * math.Cos(lambda), math.Cos(phi) * math.Sin(lambda), math.Sin(phi)}
+}
+
+func chord2(a, b point) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+// chordKm converts a squared chord length to a great-circle distance.
+func chordKm(c2 float64) float64 {
+	return 2 * math.Asin(math.Min(1, math.Sqrt(c2)/2)) * earthRadiusKm
+}
+
+type entry struct {
+	p point
+	m Municipality
+}
+
+// kdTree is an implicit k-d tree: every slice of entries has its median on
+// the splitting axis in the middle, smaller coordinates before it and
+// larger ones after.
+type kdTree []entry
+
+func newKDTree(entries []entry) kdTree {
+	build(entries, 0)
+	return entries
+}
+
+func build(entries []entry, depth int) {
+	if len(entries) < 2 {
+		return
+	}
+	axis := depth % 3
+	sort.Slice(entries, func(i, j int) bool { return entries[i].p[axis] < entries[j].p[axis] })
+	mid := len(entries) / 2
+	build(entries[:mid], depth+1)
+	build(entries[mid+1:], depth+1)
+}
+
+// nearest returns the entry closest to target and its squared chord
+// distance; the tree must not be empty.
+func (t kdTree) nearest(target point) (*entry, float64) {
+	best, bestDist := (*entry)(nil), math.Inf(1)
+	search(t, 0, target, &best, &bestDist)
+	return best, bestDist
+}
+
+func search(entries []entry, depth int, target point, best **entry, bestDist *float64) {
+	if len(entries) == 0 {
+		return
+	}
+	mid := len(entries) / 2
+	if d := chord2(entries[mid].p, target); d < *bestDist {
+		*best, *bestDist = &entries[mid], d
+	}
+
+	axis := depth % 3
+	diff := target[axis] - entries[mid].p[axis]
+	near, far := entries[:mid], entries[mid+1:]
+	if diff > 0 {
+		near, far = far, near
+	}
+	search(near, depth+1, target, best, bestDist)
+	// The far side can only be closer than the splitting plane is.
+	if diff*diff < *bestDist {
+		search(far, depth+1, target, best, bestDist)
+	}
+}
@@ -0,0 +1,94 @@
+// Package graphql serves a GraphQL view (schema.graphql) of the CEP,
+// location and weather data, for clients that want to pick the fields they
+// need in one round trip.
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/weather"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxDepth is deeper than any selection the schema allows without
+// repeating fields.
+const maxDepth = 8
+
+// Request is a GraphQL query, sent as a JSON body with POST or as query
+// parameters (variables JSON-encoded) with GET.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+type Handler struct {
+	schema *gql.Schema
+}
+
+func NewHandler() *Handler {
+	schema := gql.MustParseSchema(schemaSDL, &resolver{},
+		gql.UseFieldResolvers(),
+		gql.MaxDepth(maxDepth),
+	)
+	return &Handler{schema: schema}
+}
+
+// Register mounts the endpoint on mux:
+//
+//	GET  /graphql
+//	POST /graphql
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle("GET /graphql", h)
+	mux.Handle("POST /graphql", h)
+}
+
+// ServeHTTP answers 200 with the result, whose errors field reports
+// problems with the query or with individual fields, and 400 only when the
+// request itself can not be read.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept-Language")
+
+	req, err := decodeRequest(r)
+	if err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	lang := i18n.FromRequest(r)
+	ctx := withQuery(r.Context(), &query{lang: lang, loaders: newLoaders()})
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Language", lang)
+	w.Write(body)
+}
+
+func decodeRequest(r *http.Request) (Request, error) {
+	var req Request
+	if r.Method == http.MethodGet {
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if variables := params.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return Request{}, err
+			}
+		}
+		return req, nil
+	}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
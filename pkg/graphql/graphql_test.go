@@ -0,0 +1,238 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"temperature_server/internal/upstreamtest"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"testing"
+)
+
+// newUpstreamServer sobe o mock da ViaCEP e da WeatherAPI em que os CEPs
+// 356xxxxx ficam em Bom Despacho e os demais em Belo Horizonte.
+func newUpstreamServer(t *testing.T) *upstreamtest.Server {
+	t.Helper()
+	upstream := upstreamtest.New(t, &viacep.BaseURL, &weather.BaseURL)
+	upstream.Handle(upstreamtest.ViaCEP, func(w http.ResponseWriter, r *http.Request) {
+		cep := upstreamtest.CEP(r)
+		if cep == "99999999" {
+			w.Write([]byte(`{"erro": "true"}`))
+			return
+		}
+		city := "Belo Horizonte"
+		if strings.HasPrefix(cep, "356") {
+			city = "Bom Despacho"
+		}
+		json.NewEncoder(w).Encode(viacep.CEPResponse{CEP: cep[:5] + "-" + cep[5:], Localidade: city, UF: "MG"})
+	})
+	upstream.Handle(upstreamtest.Search, func(w http.ResponseWriter, r *http.Request) {
+		lat := -19.92
+		if r.URL.Query().Get("q") == "Bom Despacho" {
+			lat = -19.72
+		}
+		json.NewEncoder(w).Encode([]weather.Search{{Id: 7, Name: r.URL.Query().Get("q"), Lat: lat, Lon: -45.25}})
+	})
+	var data weather.WeatherResponse
+	data.Current.LastUpdatedEpoch = 1752106500
+	data.Current.TempC = 25
+	data.Current.IsDay = 1
+	data.Current.Humidity = 60
+	data.Current.Condition = weather.Condition{Text: "Sunny", Code: 1000}
+	upstream.JSON(upstreamtest.Current, data)
+	upstream.Handle(forecastRoute, func(w http.ResponseWriter, r *http.Request) {
+		var data weather.ForecastResponse
+		var days int
+		fmt.Sscan(r.URL.Query().Get("days"), &days)
+		for i := 0; i < days; i++ {
+			data.Forecast.Forecastday = append(data.Forecast.Forecastday, weather.ForecastDay{
+				Date: fmt.Sprintf("2025-07-%02d", 10+i),
+				Day:  weather.Day{MaxtempC: 27 + float64(i), Condition: weather.Condition{Text: "Sunny", Code: 1000}},
+				Hour: []weather.Hour{{Time: fmt.Sprintf("2025-07-%02d 00:00", 10+i), TempC: 15}},
+			})
+		}
+		json.NewEncoder(w).Encode(data)
+	})
+	return upstream
+}
+
+const forecastRoute = "/v1/forecast.json"
+
+// upstreamCalls resume as chamadas recebidas pelo mock, para as mensagens de erro.
+func upstreamCalls(upstream *upstreamtest.Server) string {
+	return fmt.Sprintf("viacep=%d search=%d current=%d forecast=%d", upstream.Calls(upstreamtest.ViaCEP),
+		upstream.Calls(upstreamtest.Search), upstream.Calls(upstreamtest.Current), upstream.Calls(forecastRoute))
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Path       []interface{}          `json:"path"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func post(t *testing.T, query string, variables map[string]interface{}, lang string) graphqlResponse {
+	t.Helper()
+	body, _ := json.Marshal(Request{Query: query, Variables: variables})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	if lang != "" {
+		req.Header.Set("Accept-Language", lang)
+	}
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response graphqlResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return response
+}
+
+func TestAddressOnlyCallsViaCEP(t *testing.T) {
+	calls := newUpstreamServer(t)
+
+	response := post(t, `{ cep(cep: "35630-016") { cep address { localidade uf } } }`, nil, "")
+	expected := `{"cep":{"cep":"35630016","address":{"localidade":"Bom Despacho","uf":"MG"}}}`
+	if string(response.Data) != expected {
+		t.Errorf("unexpected data:\n got %s\nwant %s", response.Data, expected)
+	}
+	// Só o endereço foi pedido: a WeatherAPI não é chamada
+	if calls.Calls(upstreamtest.ViaCEP) != 1 || calls.Calls(upstreamtest.Search) != 0 || calls.Calls(upstreamtest.Current) != 0 {
+		t.Errorf("unexpected upstream calls: %s", upstreamCalls(calls))
+	}
+}
+
+func TestCurrentWeather(t *testing.T) {
+	calls := newUpstreamServer(t)
+
+	response := post(t, `{ cep(cep: "35630016") {
+		location { id name lat }
+		current { observedAt humidity isDay temperature { celsius kelvin } condition { text code } }
+	} }`, nil, "pt-BR")
+	if len(response.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", response.Errors)
+	}
+	expected := `{"cep":{"location":{"id":7,"name":"Bom Despacho","lat":-19.72},` +
+		`"current":{"observedAt":"2025-07-10T00:15:00Z","humidity":60,"isDay":true,` +
+		`"temperature":{"celsius":25,"kelvin":298.15},"condition":{"text":"Ensolarado","code":1000}}}}`
+	if string(response.Data) != expected {
+		t.Errorf("unexpected data:\n got %s\nwant %s", response.Data, expected)
+	}
+	// location e current compartilham a mesma busca
+	if calls.Calls(upstreamtest.ViaCEP) != 1 || calls.Calls(upstreamtest.Search) != 1 || calls.Calls(upstreamtest.Current) != 1 {
+		t.Errorf("unexpected upstream calls: %s", upstreamCalls(calls))
+	}
+}
+
+func TestCEPsBatching(t *testing.T) {
+	calls := newUpstreamServer(t)
+
+	response := post(t, `query($ceps: [String!]!) { ceps(ceps: $ceps) { cep current { temperature { celsius } } } }`,
+		map[string]interface{}{"ceps": []string{"35630-016", "35630016", "35600000", "30130000", "123"}}, "")
+
+	var data struct {
+		CEPs []*struct {
+			CEP     string `json:"cep"`
+			Current *struct {
+				Temperature struct {
+					Celsius float64 `json:"celsius"`
+				} `json:"temperature"`
+			} `json:"current"`
+		} `json:"ceps"`
+	}
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if len(data.CEPs) != 5 {
+		t.Fatalf("expected 5 CEPs, got %s", response.Data)
+	}
+	for _, cep := range data.CEPs[:4] {
+		if cep.Current == nil || cep.Current.Temperature.Celsius != 25 {
+			t.Errorf("expected current weather for %s, got %s", cep.CEP, response.Data)
+		}
+	}
+
+	// O CEP inválido falha sozinho, sem derrubar a lista
+	if data.CEPs[4].Current != nil || len(response.Errors) != 1 {
+		t.Fatalf("expected one error for the invalid CEP, got %+v", response.Errors)
+	}
+	if path := fmt.Sprint(response.Errors[0].Path); path != "[ceps 4 current]" {
+		t.Errorf("unexpected error path: %s", path)
+	}
+
+	// Três CEPs distintos, duas cidades e duas coordenadas
+	if calls.Calls(upstreamtest.ViaCEP) != 3 || calls.Calls(upstreamtest.Search) != 2 || calls.Calls(upstreamtest.Current) != 2 {
+		t.Errorf("unexpected upstream calls: %s", upstreamCalls(calls))
+	}
+}
+
+func TestForecast(t *testing.T) {
+	calls := newUpstreamServer(t)
+
+	response := post(t, `{ cep(cep: "35630016") { forecast(days: 2) { date maxTemp { celsius } hours { time } } } }`, nil, "")
+	expected := `{"cep":{"forecast":[` +
+		`{"date":"2025-07-10","maxTemp":{"celsius":27},"hours":[{"time":"2025-07-10 00:00"}]},` +
+		`{"date":"2025-07-11","maxTemp":{"celsius":28},"hours":[{"time":"2025-07-11 00:00"}]}]}}`
+	if string(response.Data) != expected {
+		t.Errorf("unexpected data:\n got %s\nwant %s", response.Data, expected)
+	}
+	if calls.Calls(forecastRoute) != 1 || calls.Calls(upstreamtest.Current) != 0 {
+		t.Errorf("unexpected upstream calls: %s", upstreamCalls(calls))
+	}
+
+	response = post(t, `{ cep(cep: "35630016") { forecast(days: 5) { date } } }`, nil, "")
+	if len(response.Errors) != 1 || response.Errors[0].Message != "invalid days" {
+		t.Errorf("expected invalid days error, got %+v", response.Errors)
+	}
+}
+
+func TestUnknownCEP(t *testing.T) {
+	newUpstreamServer(t)
+
+	response := post(t, `{ cep(cep: "99999-999") { address { localidade } } }`, nil, "pt-BR")
+	expected := "não foi possível encontrar o CEP: CEP não encontrado"
+	if len(response.Errors) != 1 || response.Errors[0].Message != expected {
+		t.Errorf("expected %q, got %+v", expected, response.Errors)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	response := post(t, `{ convert(value: 212, unit: FAHRENHEIT) { celsius kelvin } }`, nil, "")
+	expected := `{"convert":{"celsius":100,"kelvin":373.15}}`
+	if string(response.Data) != expected {
+		t.Errorf("unexpected data:\n got %s\nwant %s", response.Data, expected)
+	}
+
+	response = post(t, `{ convert(value: -300) { kelvin } }`, nil, "")
+	if len(response.Errors) != 1 || response.Errors[0].Message != "temperature below absolute zero" {
+		t.Errorf("expected absolute zero error, got %+v", response.Errors)
+	}
+}
+
+func TestGetRequest(t *testing.T) {
+	params := url.Values{
+		"query":     {`query($v: Float!) { convert(value: $v) { fahrenheit } }`},
+		"variables": {`{"v": 100}`},
+	}
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != `{"data":{"convert":{"fahrenheit":212}}}` {
+		t.Errorf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestInvalidRequestBody(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("query=")))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,171 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"temperature_server/pkg/cep"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+// batchWait is how long a loader collects keys before fetching them, so
+// that the CEPs of a list, resolved concurrently, end up in one batch.
+const batchWait = 2 * time.Millisecond
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// loader batches and caches lookups for the duration of one request, in the
+// style of dataloader: keys requested within batchWait of each other are
+// dispatched together and each distinct key is fetched once. ViaCEP and
+// WeatherAPI have no bulk endpoints, so a batch is fetched concurrently.
+type loader[K comparable, V any] struct {
+	fetch func(K) (V, error)
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	pending []K
+}
+
+func newLoader[K comparable, V any](fetch func(K) (V, error)) *loader[K, V] {
+	return &loader[K, V]{fetch: fetch, results: map[K]*result[V]{}}
+}
+
+func (l *loader[K, V]) Load(key K) (V, error) {
+	l.mu.Lock()
+	res, ok := l.results[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.results[key] = res
+		l.pending = append(l.pending, key)
+		if len(l.pending) == 1 {
+			time.AfterFunc(batchWait, l.dispatch)
+		}
+	}
+	l.mu.Unlock()
+
+	<-res.done
+	return res.value, res.err
+}
+
+func (l *loader[K, V]) dispatch() {
+	l.mu.Lock()
+	keys := l.pending
+	l.pending = nil
+	batch := make([]*result[V], len(keys))
+	for i, key := range keys {
+		batch[i] = l.results[key]
+	}
+	l.mu.Unlock()
+
+	for i, key := range keys {
+		res := batch[i]
+		go func() {
+			res.value, res.err = l.fetch(key)
+			close(res.done)
+		}()
+	}
+}
+
+type coordinates struct {
+	lat, lon float64
+}
+
+type forecastKey struct {
+	coordinates
+	days int
+}
+
+// loaders are created for every request. Addresses are keyed by CEP,
+// locations by city and readings by coordinates, so CEPs of the same city
+// share the WeatherAPI calls.
+type loaders struct {
+	addresses *loader[string, *viacep.CEPResponse]
+	locations *loader[string, *weather.Search]
+	currents  *loader[coordinates, *weather.WeatherResponse]
+	forecasts *loader[forecastKey, *weather.ForecastResponse]
+}
+
+func newLoaders() *loaders {
+	return &loaders{
+		addresses: newLoader(fetchAddress),
+		locations: newLoader(weather.FecthSearchFromWeatherAPI),
+		currents: newLoader(func(c coordinates) (*weather.WeatherResponse, error) {
+			return weather.FetchWeatherData(c.lat, c.lon)
+		}),
+		forecasts: newLoader(func(k forecastKey) (*weather.ForecastResponse, error) {
+			return weather.FetchForecastData(k.lat, k.lon, k.days)
+		}),
+	}
+}
+
+// fetchAddress also fails for CEPs ViaCEP does not know, which it answers
+// with {"erro": "true"}.
+func fetchAddress(cep string) (*viacep.CEPResponse, error) {
+	address, err := viacep.FetchCEPData(cep)
+	if err != nil {
+		return nil, err
+	}
+	if address.CEP == "" {
+		return nil, errors.New("zipcode not found")
+	}
+	return address, nil
+}
+
+// normalizeCEP returns the digits of a CEP in any layout cep.Parse accepts.
+func normalizeCEP(code string) (string, error) {
+	parsed, err := cep.Parse(code)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Digits(), nil
+}
+
+func (l *loaders) address(cep string) (*viacep.CEPResponse, error) {
+	address, err := l.addresses.Load(cep)
+	if err != nil {
+		return nil, fmt.Errorf("can not find zipcode: %w", err)
+	}
+	return address, nil
+}
+
+func (l *loaders) location(cep string) (*weather.Search, error) {
+	address, err := l.address(cep)
+	if err != nil {
+		return nil, err
+	}
+	location, err := l.locations.Load(address.Localidade)
+	if err != nil {
+		return nil, fmt.Errorf("can not find city: %w", err)
+	}
+	return location, nil
+}
+
+func (l *loaders) current(cep string) (*weather.WeatherResponse, error) {
+	location, err := l.location(cep)
+	if err != nil {
+		return nil, err
+	}
+	reading, err := l.currents.Load(coordinates{location.Lat, location.Lon})
+	if err != nil {
+		return nil, fmt.Errorf("can not find weather: %w", err)
+	}
+	return reading, nil
+}
+
+func (l *loaders) forecast(cep string, days int) (*weather.ForecastResponse, error) {
+	location, err := l.location(cep)
+	if err != nil {
+		return nil, err
+	}
+	forecast, err := l.forecasts.Load(forecastKey{coordinates{location.Lat, location.Lon}, days})
+	if err != nil {
+		return nil, fmt.Errorf("can not find forecast: %w", err)
+	}
+	return forecast, nil
+}
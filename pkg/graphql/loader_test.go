@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLoaderBatchesAndDedupes(t *testing.T) {
+	var mu sync.Mutex
+	fetched := map[string]int{}
+	l := newLoader(func(key string) (string, error) {
+		mu.Lock()
+		fetched[key]++
+		mu.Unlock()
+		return "value of " + key, nil
+	})
+
+	// Chaves pedidas ao mesmo tempo são buscadas uma vez cada
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b", "a", "c", "b", "a"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, err := l.Load(key); err != nil || value != "value of "+key {
+				t.Errorf("Load(%q) = %q, %v", key, value, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(fetched) != 3 || fetched["a"] != 1 || fetched["b"] != 1 || fetched["c"] != 1 {
+		t.Errorf("expected each key fetched once, got %v", fetched)
+	}
+
+	// Depois do lote, o resultado continua em cache
+	l.Load("a")
+	if fetched["a"] != 1 {
+		t.Errorf("expected cached result, got %d fetches", fetched["a"])
+	}
+}
+
+func TestNormalizeCEP(t *testing.T) {
+	for input, expected := range map[string]string{"35630-016": "35630016", "35.630-016": "35630016", " 35630016 ": "35630016"} {
+		if cep, err := normalizeCEP(input); err != nil || cep != expected {
+			t.Errorf("normalizeCEP(%q) = %q, %v", input, cep, err)
+		}
+	}
+	for _, input := range []string{"", "123", "3563001a", "356300160"} {
+		if _, err := normalizeCEP(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
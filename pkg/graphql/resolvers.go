@@ -0,0 +1,284 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"math"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/utils"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"time"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// MaxCEPs limits how many CEPs one ceps query may look up.
+const MaxCEPs = 50
+
+// query is the per-request state resolvers find in the context.
+type query struct {
+	lang    string
+	loaders *loaders
+}
+
+type queryKey struct{}
+
+func withQuery(ctx context.Context, q *query) context.Context {
+	return context.WithValue(ctx, queryKey{}, q)
+}
+
+func queryFrom(ctx context.Context) *query {
+	return ctx.Value(queryKey{}).(*query)
+}
+
+// throttled is implemented by errors from rate-limited upstream calls
+// (ratelimit.LimitError).
+type throttled interface {
+	RetryAfter() time.Duration
+}
+
+// queryError is a resolver error translated to the request's language.
+// Throttled upstream calls report when to retry in the error's extensions.
+type queryError struct {
+	message    string
+	retryAfter time.Duration
+}
+
+func (e *queryError) Error() string {
+	return e.message
+}
+
+func (e *queryError) Extensions() map[string]any {
+	if e.retryAfter == 0 {
+		return nil
+	}
+	return map[string]any{
+		"code":       "UPSTREAM_RATE_LIMITED",
+		"retryAfter": int(math.Ceil(e.retryAfter.Seconds())),
+	}
+}
+
+func (q *query) fail(err error) error {
+	var limited throttled
+	if errors.As(err, &limited) {
+		return &queryError{message: i18n.Message(q.lang, "upstream rate limit exceeded"), retryAfter: limited.RetryAfter()}
+	}
+	return &queryError{message: i18n.Message(q.lang, err.Error())}
+}
+
+type resolver struct{}
+
+func (resolver) CEP(ctx context.Context, args struct{ CEP string }) (*cepResolver, error) {
+	q := queryFrom(ctx)
+	cep, err := normalizeCEP(args.CEP)
+	if err != nil {
+		return nil, q.fail(err)
+	}
+	return &cepResolver{q: q, cep: cep}, nil
+}
+
+// CEPs resolves invalid entries too, so that only their fields fail.
+func (resolver) CEPs(ctx context.Context, args struct{ CEPs []string }) ([]*cepResolver, error) {
+	q := queryFrom(ctx)
+	if len(args.CEPs) > MaxCEPs {
+		return nil, q.fail(errors.New("too many zipcodes"))
+	}
+	ceps := make([]*cepResolver, len(args.CEPs))
+	for i, raw := range args.CEPs {
+		cep, err := normalizeCEP(raw)
+		ceps[i] = &cepResolver{q: q, cep: cep, err: err}
+		if err != nil {
+			ceps[i].cep = raw
+		}
+	}
+	return ceps, nil
+}
+
+func (resolver) Convert(ctx context.Context, args struct {
+	Value float64
+	Unit  string
+}) (utils.Temperature, error) {
+	q := queryFrom(ctx)
+	unit, err := utils.ParseUnit(args.Unit)
+	if err != nil {
+		return utils.Temperature{}, q.fail(err)
+	}
+	t := utils.NewTemperature(args.Value, unit)
+	if t.BelowAbsoluteZero() {
+		return utils.Temperature{}, q.fail(errors.New("temperature below absolute zero"))
+	}
+	return t, nil
+}
+
+// cepResolver only calls the upstream APIs behind the fields selected:
+// address needs ViaCEP, location adds the WeatherAPI search, and current
+// and forecast add their WeatherAPI endpoint.
+type cepResolver struct {
+	q   *query
+	cep string
+	err error
+}
+
+func (c *cepResolver) CEP() string {
+	return c.cep
+}
+
+func (c *cepResolver) Address() (*viacep.CEPResponse, error) {
+	if c.err != nil {
+		return nil, c.q.fail(c.err)
+	}
+	address, err := c.q.loaders.address(c.cep)
+	if err != nil {
+		return nil, c.q.fail(err)
+	}
+	return address, nil
+}
+
+func (c *cepResolver) Location() (*locationResolver, error) {
+	if c.err != nil {
+		return nil, c.q.fail(c.err)
+	}
+	location, err := c.q.loaders.location(c.cep)
+	if err != nil {
+		return nil, c.q.fail(err)
+	}
+	return &locationResolver{*location}, nil
+}
+
+func (c *cepResolver) Current() (*currentResolver, error) {
+	if c.err != nil {
+		return nil, c.q.fail(c.err)
+	}
+	reading, err := c.q.loaders.current(c.cep)
+	if err != nil {
+		return nil, c.q.fail(err)
+	}
+	return &currentResolver{Current: reading.Current, lang: c.q.lang}, nil
+}
+
+func (c *cepResolver) Forecast(args struct{ Days int32 }) (*[]*forecastDayResolver, error) {
+	if c.err != nil {
+		return nil, c.q.fail(c.err)
+	}
+	if args.Days < 1 || args.Days > weather.MaxForecastDays {
+		return nil, c.q.fail(errors.New("invalid days"))
+	}
+	forecast, err := c.q.loaders.forecast(c.cep, int(args.Days))
+	if err != nil {
+		return nil, c.q.fail(err)
+	}
+	days := make([]*forecastDayResolver, len(forecast.Forecast.Forecastday))
+	for i, day := range forecast.Forecast.Forecastday {
+		days[i] = &forecastDayResolver{Day: day.Day, date: day.Date, hours: day.Hour, lang: c.q.lang}
+	}
+	return &days, nil
+}
+
+type locationResolver struct {
+	weather.Search
+}
+
+func (l *locationResolver) ID() int32 {
+	return int32(l.Id)
+}
+
+type conditionResolver struct {
+	weather.Condition
+	isDay bool
+	lang  string
+}
+
+func (c *conditionResolver) Text() string {
+	return i18n.Condition(c.lang, c.Condition.Code, c.isDay, c.Condition.Text)
+}
+
+func (c *conditionResolver) Code() int32 {
+	return int32(c.Condition.Code)
+}
+
+type currentResolver struct {
+	weather.Current
+	lang string
+}
+
+func (c *currentResolver) ObservedAt() gql.Time {
+	return gql.Time{Time: time.Unix(c.LastUpdatedEpoch, 0).UTC()}
+}
+
+func (c *currentResolver) Temperature() utils.Temperature { return utils.FromCelsius(c.TempC) }
+func (c *currentResolver) FeelsLike() utils.Temperature   { return utils.FromCelsius(c.FeelslikeC) }
+func (c *currentResolver) Windchill() utils.Temperature   { return utils.FromCelsius(c.WindchillC) }
+func (c *currentResolver) Heatindex() utils.Temperature   { return utils.FromCelsius(c.HeatindexC) }
+func (c *currentResolver) Dewpoint() utils.Temperature    { return utils.FromCelsius(c.DewpointC) }
+
+func (c *currentResolver) IsDay() bool {
+	return c.Current.IsDay == 1
+}
+
+func (c *currentResolver) Condition() *conditionResolver {
+	return &conditionResolver{Condition: c.Current.Condition, isDay: c.IsDay(), lang: c.lang}
+}
+
+func (c *currentResolver) WindDegree() int32 { return int32(c.Current.WindDegree) }
+func (c *currentResolver) Humidity() int32   { return int32(c.Current.Humidity) }
+func (c *currentResolver) Cloud() int32      { return int32(c.Current.Cloud) }
+
+func (c *currentResolver) AirQuality() *airQualityResolver {
+	if c.Current.AirQuality == nil {
+		return nil
+	}
+	return &airQualityResolver{*c.Current.AirQuality}
+}
+
+type airQualityResolver struct {
+	weather.AirQuality
+}
+
+func (a *airQualityResolver) UsEpaIndex() int32   { return int32(a.USEPAIndex) }
+func (a *airQualityResolver) GbDefraIndex() int32 { return int32(a.GBDefraIndex) }
+
+type forecastDayResolver struct {
+	weather.Day
+	date  string
+	hours []weather.Hour
+	lang  string
+}
+
+func (d *forecastDayResolver) Date() string               { return d.date }
+func (d *forecastDayResolver) MinTemp() utils.Temperature { return utils.FromCelsius(d.MintempC) }
+func (d *forecastDayResolver) MaxTemp() utils.Temperature { return utils.FromCelsius(d.MaxtempC) }
+func (d *forecastDayResolver) AvgTemp() utils.Temperature { return utils.FromCelsius(d.AvgtempC) }
+
+func (d *forecastDayResolver) Condition() *conditionResolver {
+	return &conditionResolver{Condition: d.Day.Condition, isDay: true, lang: d.lang}
+}
+
+func (d *forecastDayResolver) Hours() []*forecastHourResolver {
+	hours := make([]*forecastHourResolver, len(d.hours))
+	for i, hour := range d.hours {
+		hours[i] = &forecastHourResolver{Hour: hour, lang: d.lang}
+	}
+	return hours
+}
+
+type forecastHourResolver struct {
+	weather.Hour
+	lang string
+}
+
+func (h *forecastHourResolver) Temperature() utils.Temperature { return utils.FromCelsius(h.TempC) }
+func (h *forecastHourResolver) FeelsLike() utils.Temperature   { return utils.FromCelsius(h.FeelslikeC) }
+func (h *forecastHourResolver) Dewpoint() utils.Temperature    { return utils.FromCelsius(h.DewpointC) }
+
+func (h *forecastHourResolver) IsDay() bool {
+	return h.Hour.IsDay == 1
+}
+
+func (h *forecastHourResolver) Condition() *conditionResolver {
+	return &conditionResolver{Condition: h.Hour.Condition, isDay: h.IsDay(), lang: h.lang}
+}
+
+func (h *forecastHourResolver) WindDegree() int32 { return int32(h.Hour.WindDegree) }
+func (h *forecastHourResolver) Humidity() int32   { return int32(h.Hour.Humidity) }
+func (h *forecastHourResolver) Cloud() int32      { return int32(h.Hour.Cloud) }
@@ -0,0 +1,613 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: temperature.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetTemperatureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cep           string                 `protobuf:"bytes,1,opt,name=cep,proto3" json:"cep,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemperatureRequest) Reset() {
+	*x = GetTemperatureRequest{}
+	mi := &file_temperature_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemperatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemperatureRequest) ProtoMessage() {}
+
+func (x *GetTemperatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemperatureRequest.ProtoReflect.Descriptor instead.
+func (*GetTemperatureRequest) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTemperatureRequest) GetCep() string {
+	if x != nil {
+		return x.Cep
+	}
+	return ""
+}
+
+type TemperatureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TempC         float64                `protobuf:"fixed64,1,opt,name=temp_c,json=tempC,proto3" json:"temp_c,omitempty"`
+	TempF         float64                `protobuf:"fixed64,2,opt,name=temp_f,json=tempF,proto3" json:"temp_f,omitempty"`
+	TempK         float64                `protobuf:"fixed64,3,opt,name=temp_k,json=tempK,proto3" json:"temp_k,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemperatureResponse) Reset() {
+	*x = TemperatureResponse{}
+	mi := &file_temperature_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperatureResponse) ProtoMessage() {}
+
+func (x *TemperatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperatureResponse.ProtoReflect.Descriptor instead.
+func (*TemperatureResponse) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemperatureResponse) GetTempC() float64 {
+	if x != nil {
+		return x.TempC
+	}
+	return 0
+}
+
+func (x *TemperatureResponse) GetTempF() float64 {
+	if x != nil {
+		return x.TempF
+	}
+	return 0
+}
+
+func (x *TemperatureResponse) GetTempK() float64 {
+	if x != nil {
+		return x.TempK
+	}
+	return 0
+}
+
+type GetWeatherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Cep   string                 `protobuf:"bytes,1,opt,name=cep,proto3" json:"cep,omitempty"`
+	// metric (default), imperial or si.
+	Units         string `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeatherRequest) Reset() {
+	*x = GetWeatherRequest{}
+	mi := &file_temperature_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherRequest) ProtoMessage() {}
+
+func (x *GetWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherRequest.ProtoReflect.Descriptor instead.
+func (*GetWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetWeatherRequest) GetCep() string {
+	if x != nil {
+		return x.Cep
+	}
+	return ""
+}
+
+func (x *GetWeatherRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+type Measurement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Measurement) Reset() {
+	*x = Measurement{}
+	mi := &file_temperature_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Measurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Measurement) ProtoMessage() {}
+
+func (x *Measurement) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Measurement.ProtoReflect.Descriptor instead.
+func (*Measurement) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Measurement) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Measurement) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type WeatherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Units         string                 `protobuf:"bytes,1,opt,name=units,proto3" json:"units,omitempty"`
+	ObservedAt    int64                  `protobuf:"varint,2,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	Condition     string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Temperature   *Measurement           `protobuf:"bytes,4,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	FeelsLike     *Measurement           `protobuf:"bytes,5,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"`
+	Humidity      int32                  `protobuf:"varint,6,opt,name=humidity,proto3" json:"humidity,omitempty"`
+	WindSpeed     *Measurement           `protobuf:"bytes,7,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`
+	WindGust      *Measurement           `protobuf:"bytes,8,opt,name=wind_gust,json=windGust,proto3" json:"wind_gust,omitempty"`
+	WindDegree    int32                  `protobuf:"varint,9,opt,name=wind_degree,json=windDegree,proto3" json:"wind_degree,omitempty"`
+	WindDir       string                 `protobuf:"bytes,10,opt,name=wind_dir,json=windDir,proto3" json:"wind_dir,omitempty"`
+	Beaufort      int32                  `protobuf:"varint,11,opt,name=beaufort,proto3" json:"beaufort,omitempty"`
+	Pressure      *Measurement           `protobuf:"bytes,12,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	Precipitation *Measurement           `protobuf:"bytes,13,opt,name=precipitation,proto3" json:"precipitation,omitempty"`
+	Visibility    *Measurement           `protobuf:"bytes,14,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeatherResponse) Reset() {
+	*x = WeatherResponse{}
+	mi := &file_temperature_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherResponse) ProtoMessage() {}
+
+func (x *WeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherResponse.ProtoReflect.Descriptor instead.
+func (*WeatherResponse) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WeatherResponse) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetObservedAt() int64 {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetTemperature() *Measurement {
+	if x != nil {
+		return x.Temperature
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetFeelsLike() *Measurement {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetHumidity() int32 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetWindSpeed() *Measurement {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetWindGust() *Measurement {
+	if x != nil {
+		return x.WindGust
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetWindDegree() int32 {
+	if x != nil {
+		return x.WindDegree
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetWindDir() string {
+	if x != nil {
+		return x.WindDir
+	}
+	return ""
+}
+
+func (x *WeatherResponse) GetBeaufort() int32 {
+	if x != nil {
+		return x.Beaufort
+	}
+	return 0
+}
+
+func (x *WeatherResponse) GetPressure() *Measurement {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetPrecipitation() *Measurement {
+	if x != nil {
+		return x.Precipitation
+	}
+	return nil
+}
+
+func (x *WeatherResponse) GetVisibility() *Measurement {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+type WatchTemperatureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Cep   string                 `protobuf:"bytes,1,opt,name=cep,proto3" json:"cep,omitempty"`
+	// Polling interval in seconds; the server default is used when zero.
+	IntervalSeconds int32 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchTemperatureRequest) Reset() {
+	*x = WatchTemperatureRequest{}
+	mi := &file_temperature_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTemperatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTemperatureRequest) ProtoMessage() {}
+
+func (x *WatchTemperatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTemperatureRequest.ProtoReflect.Descriptor instead.
+func (*WatchTemperatureRequest) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchTemperatureRequest) GetCep() string {
+	if x != nil {
+		return x.Cep
+	}
+	return ""
+}
+
+func (x *WatchTemperatureRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type TemperatureUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cep           string                 `protobuf:"bytes,1,opt,name=cep,proto3" json:"cep,omitempty"`
+	ObservedAt    int64                  `protobuf:"varint,2,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	Temperature   *TemperatureResponse   `protobuf:"bytes,3,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemperatureUpdate) Reset() {
+	*x = TemperatureUpdate{}
+	mi := &file_temperature_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperatureUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperatureUpdate) ProtoMessage() {}
+
+func (x *TemperatureUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_temperature_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperatureUpdate.ProtoReflect.Descriptor instead.
+func (*TemperatureUpdate) Descriptor() ([]byte, []int) {
+	return file_temperature_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TemperatureUpdate) GetCep() string {
+	if x != nil {
+		return x.Cep
+	}
+	return ""
+}
+
+func (x *TemperatureUpdate) GetObservedAt() int64 {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return 0
+}
+
+func (x *TemperatureUpdate) GetTemperature() *TemperatureResponse {
+	if x != nil {
+		return x.Temperature
+	}
+	return nil
+}
+
+var File_temperature_proto protoreflect.FileDescriptor
+
+const file_temperature_proto_rawDesc = "" +
+	"\n" +
+	"\x11temperature.proto\x12\x0etemperature.v1\")\n" +
+	"\x15GetTemperatureRequest\x12\x10\n" +
+	"\x03cep\x18\x01 \x01(\tR\x03cep\"Z\n" +
+	"\x13TemperatureResponse\x12\x15\n" +
+	"\x06temp_c\x18\x01 \x01(\x01R\x05tempC\x12\x15\n" +
+	"\x06temp_f\x18\x02 \x01(\x01R\x05tempF\x12\x15\n" +
+	"\x06temp_k\x18\x03 \x01(\x01R\x05tempK\";\n" +
+	"\x11GetWeatherRequest\x12\x10\n" +
+	"\x03cep\x18\x01 \x01(\tR\x03cep\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\tR\x05units\"7\n" +
+	"\vMeasurement\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"\x84\x05\n" +
+	"\x0fWeatherResponse\x12\x14\n" +
+	"\x05units\x18\x01 \x01(\tR\x05units\x12\x1f\n" +
+	"\vobserved_at\x18\x02 \x01(\x03R\n" +
+	"observedAt\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12=\n" +
+	"\vtemperature\x18\x04 \x01(\v2\x1b.temperature.v1.MeasurementR\vtemperature\x12:\n" +
+	"\n" +
+	"feels_like\x18\x05 \x01(\v2\x1b.temperature.v1.MeasurementR\tfeelsLike\x12\x1a\n" +
+	"\bhumidity\x18\x06 \x01(\x05R\bhumidity\x12:\n" +
+	"\n" +
+	"wind_speed\x18\a \x01(\v2\x1b.temperature.v1.MeasurementR\twindSpeed\x128\n" +
+	"\twind_gust\x18\b \x01(\v2\x1b.temperature.v1.MeasurementR\bwindGust\x12\x1f\n" +
+	"\vwind_degree\x18\t \x01(\x05R\n" +
+	"windDegree\x12\x19\n" +
+	"\bwind_dir\x18\n" +
+	" \x01(\tR\awindDir\x12\x1a\n" +
+	"\bbeaufort\x18\v \x01(\x05R\bbeaufort\x127\n" +
+	"\bpressure\x18\f \x01(\v2\x1b.temperature.v1.MeasurementR\bpressure\x12A\n" +
+	"\rprecipitation\x18\r \x01(\v2\x1b.temperature.v1.MeasurementR\rprecipitation\x12;\n" +
+	"\n" +
+	"visibility\x18\x0e \x01(\v2\x1b.temperature.v1.MeasurementR\n" +
+	"visibility\"V\n" +
+	"\x17WatchTemperatureRequest\x12\x10\n" +
+	"\x03cep\x18\x01 \x01(\tR\x03cep\x12)\n" +
+	"\x10interval_seconds\x18\x02 \x01(\x05R\x0fintervalSeconds\"\x8d\x01\n" +
+	"\x11TemperatureUpdate\x12\x10\n" +
+	"\x03cep\x18\x01 \x01(\tR\x03cep\x12\x1f\n" +
+	"\vobserved_at\x18\x02 \x01(\x03R\n" +
+	"observedAt\x12E\n" +
+	"\vtemperature\x18\x03 \x01(\v2#.temperature.v1.TemperatureResponseR\vtemperature2\xa6\x02\n" +
+	"\x12TemperatureService\x12\\\n" +
+	"\x0eGetTemperature\x12%.temperature.v1.GetTemperatureRequest\x1a#.temperature.v1.TemperatureResponse\x12P\n" +
+	"\n" +
+	"GetWeather\x12!.temperature.v1.GetWeatherRequest\x1a\x1f.temperature.v1.WeatherResponse\x12`\n" +
+	"\x10WatchTemperature\x12'.temperature.v1.WatchTemperatureRequest\x1a!.temperature.v1.TemperatureUpdate0\x01B)Z'temperature_server/pkg/grpcserver/pb;pbb\x06proto3"
+
+var (
+	file_temperature_proto_rawDescOnce sync.Once
+	file_temperature_proto_rawDescData []byte
+)
+
+func file_temperature_proto_rawDescGZIP() []byte {
+	file_temperature_proto_rawDescOnce.Do(func() {
+		file_temperature_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temperature_proto_rawDesc), len(file_temperature_proto_rawDesc)))
+	})
+	return file_temperature_proto_rawDescData
+}
+
+var file_temperature_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_temperature_proto_goTypes = []any{
+	(*GetTemperatureRequest)(nil),   // 0: temperature.v1.GetTemperatureRequest
+	(*TemperatureResponse)(nil),     // 1: temperature.v1.TemperatureResponse
+	(*GetWeatherRequest)(nil),       // 2: temperature.v1.GetWeatherRequest
+	(*Measurement)(nil),             // 3: temperature.v1.Measurement
+	(*WeatherResponse)(nil),         // 4: temperature.v1.WeatherResponse
+	(*WatchTemperatureRequest)(nil), // 5: temperature.v1.WatchTemperatureRequest
+	(*TemperatureUpdate)(nil),       // 6: temperature.v1.TemperatureUpdate
+}
+var file_temperature_proto_depIdxs = []int32{
+	3,  // 0: temperature.v1.WeatherResponse.temperature:type_name -> temperature.v1.Measurement
+	3,  // 1: temperature.v1.WeatherResponse.feels_like:type_name -> temperature.v1.Measurement
+	3,  // 2: temperature.v1.WeatherResponse.wind_speed:type_name -> temperature.v1.Measurement
+	3,  // 3: temperature.v1.WeatherResponse.wind_gust:type_name -> temperature.v1.Measurement
+	3,  // 4: temperature.v1.WeatherResponse.pressure:type_name -> temperature.v1.Measurement
+	3,  // 5: temperature.v1.WeatherResponse.precipitation:type_name -> temperature.v1.Measurement
+	3,  // 6: temperature.v1.WeatherResponse.visibility:type_name -> temperature.v1.Measurement
+	1,  // 7: temperature.v1.TemperatureUpdate.temperature:type_name -> temperature.v1.TemperatureResponse
+	0,  // 8: temperature.v1.TemperatureService.GetTemperature:input_type -> temperature.v1.GetTemperatureRequest
+	2,  // 9: temperature.v1.TemperatureService.GetWeather:input_type -> temperature.v1.GetWeatherRequest
+	5,  // 10: temperature.v1.TemperatureService.WatchTemperature:input_type -> temperature.v1.WatchTemperatureRequest
+	1,  // 11: temperature.v1.TemperatureService.GetTemperature:output_type -> temperature.v1.TemperatureResponse
+	4,  // 12: temperature.v1.TemperatureService.GetWeather:output_type -> temperature.v1.WeatherResponse
+	6,  // 13: temperature.v1.TemperatureService.WatchTemperature:output_type -> temperature.v1.TemperatureUpdate
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_temperature_proto_init() }
+func file_temperature_proto_init() {
+	if File_temperature_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temperature_proto_rawDesc), len(file_temperature_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_temperature_proto_goTypes,
+		DependencyIndexes: file_temperature_proto_depIdxs,
+		MessageInfos:      file_temperature_proto_msgTypes,
+	}.Build()
+	File_temperature_proto = out.File
+	file_temperature_proto_goTypes = nil
+	file_temperature_proto_depIdxs = nil
+}
@@ -0,0 +1,188 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"temperature_server/pkg/cep"
+	"temperature_server/pkg/grpcserver/pb"
+	"temperature_server/pkg/utils"
+	"temperature_server/pkg/weather"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPollInterval = time.Minute
+	MinPollInterval     = 5 * time.Second
+)
+
+type Server struct {
+	pb.UnimplementedTemperatureServiceServer
+
+	// Fetch is the same lookup used by the HTTP handlers.
+	Fetch        func(cep string) (*weather.WeatherResponse, error)
+	PollInterval time.Duration
+}
+
+func NewServer() *Server {
+	return &Server{
+		Fetch:        weather.GetWeatherByCEP,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+func (s *Server) GetTemperature(ctx context.Context, req *pb.GetTemperatureRequest) (*pb.TemperatureResponse, error) {
+	if req.GetCep() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid zipcode")
+	}
+
+	weatherData, err := s.Fetch(req.GetCep())
+	if err != nil {
+		return nil, fetchError(err)
+	}
+
+	return toTemperatureResponse(weatherData.Current), nil
+}
+
+func (s *Server) GetWeather(ctx context.Context, req *pb.GetWeatherRequest) (*pb.WeatherResponse, error) {
+	if req.GetCep() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid zipcode")
+	}
+	system, err := utils.ParseUnitSystem(req.GetUnits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid unit system")
+	}
+
+	weatherData, err := s.Fetch(req.GetCep())
+	if err != nil {
+		return nil, fetchError(err)
+	}
+
+	return toWeatherResponse(weather.NewConditionsResponse(weatherData.Current, system)), nil
+}
+
+// WatchTemperature sends a reading whenever WeatherAPI publishes a new one.
+// Failed lookups skip the tick and are retried on the next one, unless the
+// CEP itself is invalid.
+func (s *Server) WatchTemperature(req *pb.WatchTemperatureRequest, stream pb.TemperatureService_WatchTemperatureServer) error {
+	if !cep.Valid(req.GetCep()) {
+		return status.Error(codes.InvalidArgument, "invalid zipcode")
+	}
+
+	interval := s.PollInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = time.Duration(req.GetIntervalSeconds()) * time.Second
+		if interval < MinPollInterval {
+			interval = MinPollInterval
+		}
+	}
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastObserved int64
+	for {
+		weatherData, err := s.Fetch(req.GetCep())
+		switch {
+		case invalidCEP(err):
+			return fetchError(err)
+		case err != nil:
+			// Throttled or failed upstream calls: try again next tick.
+		case weatherData.Current.LastUpdatedEpoch != lastObserved:
+			lastObserved = weatherData.Current.LastUpdatedEpoch
+			update := &pb.TemperatureUpdate{
+				Cep:         req.GetCep(),
+				ObservedAt:  lastObserved,
+				Temperature: toTemperatureResponse(weatherData.Current),
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// throttled is implemented by errors from rate-limited upstream calls
+// (ratelimit.LimitError).
+type throttled interface {
+	RetryAfter() time.Duration
+}
+
+func invalidCEP(err error) bool {
+	return errors.Is(err, cep.ErrInvalid) || errors.Is(err, cep.ErrUFMismatch)
+}
+
+// fetchError maps a failed lookup to its status: InvalidArgument for CEPs
+// that will never resolve, ResourceExhausted for throttled upstream calls
+// and Internal otherwise.
+func fetchError(err error) error {
+	var limited throttled
+	switch {
+	case invalidCEP(err):
+		return status.Error(codes.InvalidArgument, "invalid zipcode")
+	case errors.As(err, &limited):
+		return status.Error(codes.ResourceExhausted, "upstream rate limit exceeded")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func toTemperatureResponse(current weather.Current) *pb.TemperatureResponse {
+	response := weather.NewTemperatureResponse(utils.FromCelsius(current.TempC))
+	return &pb.TemperatureResponse{
+		TempC: response.Temp_C,
+		TempF: response.Temp_F,
+		TempK: response.Temp_K,
+	}
+}
+
+func toMeasurement(m weather.Measurement) *pb.Measurement {
+	return &pb.Measurement{Value: m.Value, Unit: m.Unit}
+}
+
+func toWeatherResponse(c *weather.ConditionsResponse) *pb.WeatherResponse {
+	return &pb.WeatherResponse{
+		Units:         string(c.Units),
+		ObservedAt:    c.ObservedAt,
+		Condition:     c.Condition,
+		Temperature:   toMeasurement(c.Temperature),
+		FeelsLike:     toMeasurement(c.FeelsLike),
+		Humidity:      int32(c.Humidity),
+		WindSpeed:     toMeasurement(c.WindSpeed),
+		WindGust:      toMeasurement(c.WindGust),
+		WindDegree:    int32(c.WindDegree),
+		WindDir:       c.WindDir,
+		Beaufort:      int32(c.Beaufort),
+		Pressure:      toMeasurement(c.Pressure),
+		Precipitation: toMeasurement(c.Precipitation),
+		Visibility:    toMeasurement(c.Visibility),
+	}
+}
+
+func Register(grpcServer *grpc.Server, server *Server) {
+	pb.RegisterTemperatureServiceServer(grpcServer, server)
+}
+
+// ListenAndServe serves the TemperatureService on addr until the listener
+// fails.
+func ListenAndServe(addr string, server *Server) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	grpcServer := grpc.NewServer()
+	Register(grpcServer, server)
+	return grpcServer.Serve(listener)
+}
@@ -0,0 +1,219 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"temperature_server/pkg/cep"
+	"temperature_server/pkg/grpcserver/pb"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeFetcher devolve leituras em sequência, repetindo a última.
+type fakeFetcher struct {
+	mu       sync.Mutex
+	readings []weather.Current
+	calls    int
+}
+
+func (f *fakeFetcher) Fetch(cep string) (*weather.WeatherResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if cep == "99999999" {
+		return nil, errors.New("can not find zipcode: invalid zipcode")
+	}
+	index := f.calls
+	if index >= len(f.readings) {
+		index = len(f.readings) - 1
+	}
+	f.calls++
+	return &weather.WeatherResponse{Current: f.readings[index]}, nil
+}
+
+func newTestClient(t *testing.T, server *Server) pb.TemperatureServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	Register(grpcServer, server)
+	go grpcServer.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial bufconn: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		grpcServer.Stop()
+	})
+	return pb.NewTemperatureServiceClient(conn)
+}
+
+func TestGetTemperature(t *testing.T) {
+	fetcher := &fakeFetcher{readings: []weather.Current{{TempC: 25, LastUpdatedEpoch: 100}}}
+	client := newTestClient(t, &Server{Fetch: fetcher.Fetch})
+
+	response, err := client.GetTemperature(context.Background(), &pb.GetTemperatureRequest{Cep: "35630016"})
+	if err != nil {
+		t.Fatalf("GetTemperature() error = %v", err)
+	}
+	if response.GetTempC() != 25 || response.GetTempF() != 77 || response.GetTempK() != 298.15 {
+		t.Errorf("Unexpected temperatures: %v", response)
+	}
+}
+
+func TestGetTemperature_Errors(t *testing.T) {
+	fetcher := &fakeFetcher{readings: []weather.Current{{TempC: 25}}}
+	client := newTestClient(t, &Server{Fetch: fetcher.Fetch})
+
+	tests := []struct {
+		name string
+		cep  string
+		code codes.Code
+	}{
+		{name: "CEP vazio", cep: "", code: codes.InvalidArgument},
+		{name: "CEP inexistente", cep: "99999999", code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetTemperature(context.Background(), &pb.GetTemperatureRequest{Cep: tt.cep})
+			if status.Code(err) != tt.code {
+				t.Errorf("Expected code %s, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestGetWeather(t *testing.T) {
+	fetcher := &fakeFetcher{readings: []weather.Current{{TempC: 25, WindKph: 36, PressureMb: 1013.25, VisKm: 10}}}
+	client := newTestClient(t, &Server{Fetch: fetcher.Fetch})
+
+	response, err := client.GetWeather(context.Background(), &pb.GetWeatherRequest{Cep: "35630016", Units: "si"})
+	if err != nil {
+		t.Fatalf("GetWeather() error = %v", err)
+	}
+	if response.GetUnits() != "si" {
+		t.Errorf("Expected units si, got %s", response.GetUnits())
+	}
+	if response.GetTemperature().GetValue() != 298.15 || response.GetTemperature().GetUnit() != "K" {
+		t.Errorf("Unexpected temperature: %v", response.GetTemperature())
+	}
+	if response.GetWindSpeed().GetValue() != 10 || response.GetWindSpeed().GetUnit() != "m/s" {
+		t.Errorf("Unexpected wind speed: %v", response.GetWindSpeed())
+	}
+
+	_, err = client.GetWeather(context.Background(), &pb.GetWeatherRequest{Cep: "35630016", Units: "nautical"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for unknown units, got %v", err)
+	}
+}
+
+func TestWatchTemperature(t *testing.T) {
+	fetcher := &fakeFetcher{readings: []weather.Current{
+		{TempC: 20, LastUpdatedEpoch: 100},
+		{TempC: 20, LastUpdatedEpoch: 100},
+		{TempC: 21, LastUpdatedEpoch: 200},
+		{TempC: 22, LastUpdatedEpoch: 300},
+	}}
+	client := newTestClient(t, &Server{Fetch: fetcher.Fetch, PollInterval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchTemperature(ctx, &pb.WatchTemperatureRequest{Cep: "35630016"})
+	if err != nil {
+		t.Fatalf("WatchTemperature() error = %v", err)
+	}
+
+	// Leituras repetidas não devem gerar atualizações
+	for _, expected := range []int64{100, 200, 300} {
+		update, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if update.GetObservedAt() != expected {
+			t.Errorf("Expected observed_at %d, got %d", expected, update.GetObservedAt())
+		}
+		if update.GetCep() != "35630016" {
+			t.Errorf("Expected cep 35630016, got %s", update.GetCep())
+		}
+	}
+}
+
+type throttledError struct{}
+
+func (throttledError) Error() string             { return "upstream rate limit exceeded" }
+func (throttledError) RetryAfter() time.Duration { return time.Second }
+
+func TestGetTemperature_Throttled(t *testing.T) {
+	fetch := func(cep string) (*weather.WeatherResponse, error) {
+		return nil, fmt.Errorf("can not find city: %w", throttledError{})
+	}
+	client := newTestClient(t, &Server{Fetch: fetch})
+
+	_, err := client.GetTemperature(context.Background(), &pb.GetTemperatureRequest{Cep: "35630016"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestWatchTemperature_RetriesTransientErrors(t *testing.T) {
+	// As duas primeiras consultas falham; o stream continua e entrega a leitura
+	var calls int
+	fetch := func(cep string) (*weather.WeatherResponse, error) {
+		calls++
+		switch calls {
+		case 1:
+			return nil, errors.New("status code: 502")
+		case 2:
+			return nil, throttledError{}
+		}
+		return &weather.WeatherResponse{Current: weather.Current{TempC: 20, LastUpdatedEpoch: 100}}, nil
+	}
+	client := newTestClient(t, &Server{Fetch: fetch, PollInterval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.WatchTemperature(ctx, &pb.WatchTemperatureRequest{Cep: "35630016"})
+	if err != nil {
+		t.Fatalf("WatchTemperature() error = %v", err)
+	}
+	update, err := stream.Recv()
+	if err != nil || update.GetObservedAt() != 100 {
+		t.Fatalf("Expected the reading after the failures, got %v, %v", update, err)
+	}
+}
+
+func TestWatchTemperature_InvalidCEP(t *testing.T) {
+	fetch := func(code string) (*weather.WeatherResponse, error) {
+		return nil, fmt.Errorf("can not find zipcode: %w", cep.ErrUFMismatch)
+	}
+	client := newTestClient(t, &Server{Fetch: fetch, PollInterval: 10 * time.Millisecond})
+
+	for _, code := range []string{"", "123", "35630016"} {
+		stream, err := client.WatchTemperature(context.Background(), &pb.WatchTemperatureRequest{Cep: code})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%q: expected InvalidArgument, got %v", code, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package history
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+const (
+	DefaultInterval = time.Hour
+	MinInterval     = time.Minute
+	maxBuckets      = 10000
+)
+
+type HistoryResponse struct {
+	CEP      string   `json:"cep"`
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Interval string   `json:"interval"`
+	Buckets  []Bucket `json:"buckets"`
+}
+
+// parseTime accepts RFC 3339, a plain YYYY-MM-DD date (UTC) or Unix seconds.
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(epoch, 0).UTC(), nil
+	}
+	return time.Time{}, errors.New("invalid time")
+}
+
+type Handler struct {
+	Store *Store
+	Now   func() time.Time
+}
+
+func NewHandler(store *Store) *Handler {
+	return &Handler{Store: store, Now: time.Now}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept")
+	w.Header().Add("Vary", "Accept-Language")
+	format, err := render.Negotiate(r)
+	if err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusNotAcceptable, "not acceptable")
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		weather.WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	cep := normalizeCEP(query.Get("cep"))
+	if cep == "" {
+		weather.WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	to := h.Now().UTC()
+	if value := query.Get("to"); value != "" {
+		if to, err = parseTime(value); err != nil {
+			weather.WriteError(w, r, format, http.StatusBadRequest, "invalid to")
+			return
+		}
+	}
+	from := to.Add(-24 * time.Hour)
+	if value := query.Get("from"); value != "" {
+		if from, err = parseTime(value); err != nil {
+			weather.WriteError(w, r, format, http.StatusBadRequest, "invalid from")
+			return
+		}
+	}
+	if !from.Before(to) {
+		weather.WriteError(w, r, format, http.StatusBadRequest, "from must be before to")
+		return
+	}
+
+	interval := DefaultInterval
+	if value := query.Get("interval"); value != "" {
+		interval, err = time.ParseDuration(value)
+		if err != nil || interval < MinInterval {
+			weather.WriteError(w, r, format, http.StatusBadRequest, "invalid interval")
+			return
+		}
+	}
+	if to.Sub(from)/interval > maxBuckets {
+		weather.WriteError(w, r, format, http.StatusBadRequest, "too many buckets")
+		return
+	}
+
+	observations, err := h.Store.Query(cep, from, to)
+	if err != nil {
+		weather.WriteError(w, r, format, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.Write(w, format, http.StatusOK, HistoryResponse{
+		CEP:      cep,
+		From:     from.UTC().Format(time.RFC3339),
+		To:       to.UTC().Format(time.RFC3339),
+		Interval: interval.String(),
+		Buckets:  Downsample(observations, interval),
+	})
+}
@@ -0,0 +1,93 @@
+package history
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	store := openTestStore(t)
+	base := time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC).Unix()
+	for i := 0; i < 6; i++ {
+		store.Record(weather.Observation{
+			CEP:        "35630016",
+			TempC:      float64(20 + i),
+			Humidity:   60,
+			ObservedAt: base + int64(i)*20*60,
+		})
+	}
+
+	handler := NewHandler(store)
+	handler.Now = func() time.Time { return time.Date(2025, 7, 9, 12, 0, 0, 0, time.UTC) }
+	return handler
+}
+
+func TestHandlerDefaultWindow(t *testing.T) {
+	handler := newTestHandler(t)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/history?cep=35630-016", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response HistoryResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Interval != "1h0m0s" || len(response.Buckets) != 2 {
+		t.Fatalf("Expected 2 hourly buckets, got %+v", response)
+	}
+	if response.Buckets[0].Count != 3 || response.Buckets[0].MinC != 20 || response.Buckets[0].MaxC != 22 || response.Buckets[0].AvgC != 21 {
+		t.Errorf("Unexpected first bucket: %+v", response.Buckets[0])
+	}
+}
+
+func TestHandlerRangeAndInterval(t *testing.T) {
+	handler := newTestHandler(t)
+
+	rec := httptest.NewRecorder()
+	url := "/history?cep=35630016&from=2025-07-09T00:30:00Z&to=2025-07-09&interval=30m"
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for from after to, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	url = "/history?cep=35630016&from=2025-07-09T00:30:00Z&to=2025-07-09T02:00:00Z&interval=30m"
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+
+	var response HistoryResponse
+	json.NewDecoder(rec.Body).Decode(&response)
+	if len(response.Buckets) != 3 {
+		t.Fatalf("Expected 3 buckets, got %+v", response.Buckets)
+	}
+	if response.Buckets[0].Count != 1 || response.Buckets[0].MinC != 22 {
+		t.Errorf("Unexpected first bucket: %+v", response.Buckets[0])
+	}
+}
+
+func TestHandlerInvalidParams(t *testing.T) {
+	handler := newTestHandler(t)
+
+	for _, url := range []string{
+		"/history",
+		"/history?cep=35630016&from=yesterday",
+		"/history?cep=35630016&to=tomorrow",
+		"/history?cep=35630016&interval=10s",
+		"/history?cep=35630016&interval=abc",
+		"/history?cep=35630016&from=0&interval=1m",
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", url, rec.Code)
+		}
+	}
+}
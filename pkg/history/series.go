@@ -0,0 +1,68 @@
+package history
+
+import (
+	"math"
+	"temperature_server/pkg/utils"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+type Bucket struct {
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Count       int       `json:"count"`
+	MinC        float64   `json:"min_C"`
+	MaxC        float64   `json:"max_C"`
+	AvgC        float64   `json:"avg_C"`
+	AvgHumidity float64   `json:"avg_humidity"`
+}
+
+// Downsample groups observations into interval-wide buckets aligned to the
+// Unix epoch. Buckets without observations are omitted.
+func Downsample(observations []weather.Observation, interval time.Duration) []Bucket {
+	buckets := []Bucket{}
+	step := int64(interval / time.Second)
+	if step <= 0 {
+		return buckets
+	}
+
+	var current *Bucket
+	var sumC, sumHumidity float64
+	flush := func() {
+		if current == nil {
+			return
+		}
+		current.AvgC = utils.RoundTo(sumC/float64(current.Count), 2)
+		current.AvgHumidity = utils.RoundTo(sumHumidity/float64(current.Count), 2)
+		buckets = append(buckets, *current)
+	}
+
+	for _, obs := range observations {
+		start := obs.ObservedAt - mod(obs.ObservedAt, step)
+		if current == nil || current.Start.Unix() != start {
+			flush()
+			current = &Bucket{
+				Start: time.Unix(start, 0).UTC(),
+				End:   time.Unix(start+step, 0).UTC(),
+				MinC:  math.Inf(1),
+				MaxC:  math.Inf(-1),
+			}
+			sumC, sumHumidity = 0, 0
+		}
+		current.Count++
+		current.MinC = math.Min(current.MinC, obs.TempC)
+		current.MaxC = math.Max(current.MaxC, obs.TempC)
+		sumC += obs.TempC
+		sumHumidity += float64(obs.Humidity)
+	}
+	flush()
+	return buckets
+}
+
+func mod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
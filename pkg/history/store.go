@@ -0,0 +1,102 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"temperature_server/pkg/cep"
+	"temperature_server/pkg/weather"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var observationsBucket = []byte("observations")
+
+// Store persists observations in a bbolt file, one nested bucket per CEP
+// keyed by the big-endian observation epoch, so range queries are a cursor
+// seek and repeated readings of the same observation overwrite each other.
+type Store struct {
+	db *bolt.DB
+}
+
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(observationsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// normalizeCEP returns the bucket name of code, or "" when it is not a CEP.
+func normalizeCEP(code string) string {
+	parsed, err := cep.Parse(code)
+	if err != nil {
+		return ""
+	}
+	return parsed.Digits()
+}
+
+func epochKey(epoch int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(epoch))
+	return key
+}
+
+func (s *Store) Record(obs weather.Observation) error {
+	obs.CEP = normalizeCEP(obs.CEP)
+	if obs.CEP == "" || obs.ObservedAt <= 0 {
+		return errors.New("observation without cep or time")
+	}
+
+	value, err := json.Marshal(obs)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(observationsBucket).CreateBucketIfNotExists([]byte(obs.CEP))
+		if err != nil {
+			return err
+		}
+		return bucket.Put(epochKey(obs.ObservedAt), value)
+	})
+}
+
+// Query returns the observations for cep with from <= observed_at < to,
+// oldest first.
+func (s *Store) Query(cep string, from, to time.Time) ([]weather.Observation, error) {
+	observations := []weather.Observation{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(observationsBucket).Bucket([]byte(normalizeCEP(cep)))
+		if bucket == nil {
+			return nil
+		}
+
+		end := to.Unix()
+		cursor := bucket.Cursor()
+		for key, value := cursor.Seek(epochKey(from.Unix())); key != nil; key, value = cursor.Next() {
+			if int64(binary.BigEndian.Uint64(key)) >= end {
+				break
+			}
+			var obs weather.Observation
+			if err := json.Unmarshal(value, &obs); err != nil {
+				return err
+			}
+			observations = append(observations, obs)
+		}
+		return nil
+	})
+	return observations, err
+}
@@ -0,0 +1,90 @@
+package history
+
+import (
+	"path/filepath"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := Open(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestStoreQueryRange(t *testing.T) {
+	store := openTestStore(t)
+
+	for i, temp := range []float64{20, 22, 24, 26} {
+		err := store.Record(weather.Observation{CEP: "35630-016", TempC: temp, ObservedAt: 1000 + int64(i)*600})
+		if err != nil {
+			t.Fatalf("Failed to record: %v", err)
+		}
+	}
+	store.Record(weather.Observation{CEP: "01001000", TempC: 30, ObservedAt: 1000})
+
+	observations, err := store.Query("35630016", time.Unix(1600, 0), time.Unix(2800, 0))
+	if err != nil {
+		t.Fatalf("Failed to query: %v", err)
+	}
+	if len(observations) != 2 {
+		t.Fatalf("Expected 2 observations, got %d", len(observations))
+	}
+	if observations[0].TempC != 22 || observations[1].TempC != 24 {
+		t.Errorf("Expected 22 and 24, got %+v", observations)
+	}
+}
+
+func TestStoreRecordOverwritesSameObservation(t *testing.T) {
+	store := openTestStore(t)
+
+	store.Record(weather.Observation{CEP: "35630016", TempC: 20, ObservedAt: 1000})
+	store.Record(weather.Observation{CEP: "35630016", TempC: 21, ObservedAt: 1000})
+
+	observations, _ := store.Query("35630016", time.Unix(0, 0), time.Unix(2000, 0))
+	if len(observations) != 1 || observations[0].TempC != 21 {
+		t.Errorf("Expected a single observation with 21, got %+v", observations)
+	}
+}
+
+func TestStoreRecordRejectsIncomplete(t *testing.T) {
+	store := openTestStore(t)
+
+	if err := store.Record(weather.Observation{TempC: 20, ObservedAt: 1000}); err == nil {
+		t.Error("Expected error for observation without CEP")
+	}
+	if err := store.Record(weather.Observation{CEP: "35630016", TempC: 20}); err == nil {
+		t.Error("Expected error for observation without time")
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	observations := []weather.Observation{
+		{TempC: 20, Humidity: 50, ObservedAt: 3600},
+		{TempC: 24, Humidity: 70, ObservedAt: 3600 + 1800},
+		{TempC: 18, Humidity: 80, ObservedAt: 3*3600 + 10},
+	}
+
+	buckets := Downsample(observations, time.Hour)
+	if len(buckets) != 2 {
+		t.Fatalf("Expected 2 buckets, got %d", len(buckets))
+	}
+
+	first := buckets[0]
+	if first.Start.Unix() != 3600 || first.End.Unix() != 7200 {
+		t.Errorf("Unexpected first bucket bounds: %v - %v", first.Start, first.End)
+	}
+	if first.Count != 2 || first.MinC != 20 || first.MaxC != 24 || first.AvgC != 22 || first.AvgHumidity != 60 {
+		t.Errorf("Unexpected first bucket: %+v", first)
+	}
+
+	second := buckets[1]
+	if second.Start.Unix() != 3*3600 || second.Count != 1 || second.MinC != 18 || second.MaxC != 18 {
+		t.Errorf("Unexpected second bucket: %+v", second)
+	}
+}
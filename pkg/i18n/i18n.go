@@ -0,0 +1,106 @@
+// Package i18n translates WeatherAPI condition texts and API error messages.
+// Catalogs live in catalogs/<tag>.json and are embedded in the binary;
+// English is the source language, so en.json only carries conditions.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	English    = "en"
+	Portuguese = "pt-BR"
+	Spanish    = "es"
+
+	Default = English
+)
+
+// Supported lists the catalogs in matcher preference order; the first one
+// is used when nothing in the request matches.
+var Supported = []string{English, Portuguese, Spanish}
+
+//go:embed catalogs/*.json
+var catalogFS embed.FS
+
+type catalog struct {
+	Conditions map[string]string `json:"conditions"`
+	Messages   map[string]string `json:"messages"`
+}
+
+var catalogs = loadCatalogs()
+
+var matcher = func() language.Matcher {
+	tags := make([]language.Tag, len(Supported))
+	for i, lang := range Supported {
+		tags[i] = language.MustParse(lang)
+	}
+	return language.NewMatcher(tags)
+}()
+
+func loadCatalogs() map[string]catalog {
+	loaded := map[string]catalog{}
+	for _, lang := range Supported {
+		data, err := catalogFS.ReadFile("catalogs/" + lang + ".json")
+		if err != nil {
+			panic(err)
+		}
+		var c catalog
+		if err := json.Unmarshal(data, &c); err != nil {
+			panic("i18n: " + lang + ".json: " + err.Error())
+		}
+		loaded[lang] = c
+	}
+	return loaded
+}
+
+// Match picks the supported language for the given preferences, each being
+// a tag ("pt") or an Accept-Language header ("es-AR,es;q=0.9,en;q=0.5").
+// Regional variants fall back to their base language (pt-PT → pt-BR,
+// es-419 → es) and anything unsupported to Default.
+func Match(preferences ...string) string {
+	_, index := language.MatchStrings(matcher, preferences...)
+	return Supported[index]
+}
+
+// FromRequest resolves the language from ?lang=, then Accept-Language.
+func FromRequest(r *http.Request) string {
+	return Match(r.URL.Query().Get("lang"), r.Header.Get("Accept-Language"))
+}
+
+// Message translates an English message. Wrapped errors such as
+// "can not find zipcode: invalid zipcode" are translated segment by
+// segment; unknown segments are kept in English.
+func Message(lang, message string) string {
+	if translated, ok := catalogs[lang].Messages[message]; ok {
+		return translated
+	}
+	prefix, rest, found := strings.Cut(message, ": ")
+	if !found {
+		return message
+	}
+	return Message(lang, prefix) + ": " + Message(lang, rest)
+}
+
+// Condition translates a WeatherAPI condition code, preferring the night
+// variant when isDay is false. fallback (usually the upstream text) is
+// returned for unknown codes.
+func Condition(lang string, code int, isDay bool, fallback string) string {
+	key := strconv.Itoa(code)
+	for _, c := range []catalog{catalogs[lang], catalogs[Default]} {
+		if !isDay {
+			if text, ok := c.Conditions[key+".night"]; ok {
+				return text
+			}
+		}
+		if text, ok := c.Conditions[key]; ok {
+			return text
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,121 @@
+package i18n
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		lang, acceptLanguage string
+		expected             string
+	}{
+		{"", "", English},
+		{"", "pt-BR,pt;q=0.9", Portuguese},
+		{"", "pt-PT", Portuguese},
+		{"", "es-AR,es;q=0.9", Spanish},
+		{"", "es-419", Spanish},
+		{"", "fr-FR,es;q=0.5", Spanish},
+		{"", "fr-FR,de;q=0.5", English},
+		{"", "en-US", English},
+		{"pt", "", Portuguese},
+		{"PT-br", "es", Portuguese},
+		{"xx", "es", Spanish},
+		{"es", "pt-BR", Spanish},
+	}
+
+	for _, test := range tests {
+		if got := Match(test.lang, test.acceptLanguage); got != test.expected {
+			t.Errorf("Match(%q, %q): expected %s, got %s", test.lang, test.acceptLanguage, test.expected, got)
+		}
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/temperature?lang=es", nil)
+	r.Header.Set("Accept-Language", "pt-BR")
+	if got := FromRequest(r); got != Spanish {
+		t.Errorf("Expected ?lang= to win, got %s", got)
+	}
+
+	r = httptest.NewRequest("GET", "/temperature", nil)
+	r.Header.Set("Accept-Language", "pt-BR")
+	if got := FromRequest(r); got != Portuguese {
+		t.Errorf("Expected Accept-Language to be used, got %s", got)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		lang, message, expected string
+	}{
+		{Portuguese, "invalid zipcode", "CEP inválido"},
+		{Spanish, "method not allowed", "método no permitido"},
+		{English, "invalid zipcode", "invalid zipcode"},
+		{Portuguese, "can not find zipcode: invalid zipcode", "não foi possível encontrar o CEP: CEP inválido"},
+		{Portuguese, "date out of range: 2025-07-02/2025-07-09", "data fora do intervalo permitido: 2025-07-02/2025-07-09"},
+		{Spanish, "something unexpected", "something unexpected"},
+		{"fr", "invalid zipcode", "invalid zipcode"},
+	}
+
+	for _, test := range tests {
+		if got := Message(test.lang, test.message); got != test.expected {
+			t.Errorf("Message(%s, %q): expected %q, got %q", test.lang, test.message, test.expected, got)
+		}
+	}
+}
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		lang     string
+		code     int
+		isDay    bool
+		expected string
+	}{
+		{Portuguese, 1003, true, "Parcialmente nublado"},
+		{Portuguese, 1000, true, "Ensolarado"},
+		{Portuguese, 1000, false, "Céu limpo"},
+		{Spanish, 1195, true, "Lluvia intensa"},
+		{English, 1000, false, "Clear"},
+		{"fr", 1183, true, "Light rain"},
+		{Portuguese, 9999, true, "upstream text"},
+	}
+
+	for _, test := range tests {
+		if got := Condition(test.lang, test.code, test.isDay, "upstream text"); got != test.expected {
+			t.Errorf("Condition(%s, %d, %t): expected %q, got %q", test.lang, test.code, test.isDay, test.expected, got)
+		}
+	}
+}
+
+// TestCatalogsComplete garante que todo catálogo traduz as mesmas condições
+// e que os idiomas não ingleses traduzem as mesmas mensagens.
+func TestCatalogsComplete(t *testing.T) {
+	reference := catalogs[English]
+	if len(reference.Conditions) < 48 {
+		t.Errorf("Expected all WeatherAPI condition codes, got %d", len(reference.Conditions))
+	}
+	for key := range reference.Conditions {
+		if _, err := strconv.Atoi(key); err != nil && key != "1000.night" {
+			t.Errorf("Unexpected condition key %q", key)
+		}
+	}
+
+	for _, lang := range Supported {
+		c := catalogs[lang]
+		for key := range reference.Conditions {
+			if c.Conditions[key] == "" {
+				t.Errorf("%s: missing condition %s", lang, key)
+			}
+		}
+		if lang == English {
+			continue
+		}
+		for key := range catalogs[Portuguese].Messages {
+			if c.Messages[key] == "" {
+				t.Errorf("%s: missing message %q", lang, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAccessLog(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	handler := Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"can not find zipcode"}`))
+	}), RequestID, AccessLog(logger))
+
+	req := httptest.NewRequest("GET", "/temperature?cep=99999999", nil)
+	req.Header.Set(RequestIDHeader, "req-2")
+	req.Header.Set("User-Agent", "curl/8.0")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("Expected one JSON record, got %s", logs.String())
+	}
+	expected := map[string]interface{}{
+		"level":      "WARN",
+		"msg":        "request",
+		"request_id": "req-2",
+		"method":     "GET",
+		"path":       "/temperature",
+		"query":      "cep=99999999",
+		"status":     float64(404),
+		"bytes":      float64(32),
+		"user_agent": "curl/8.0",
+	}
+	for key, value := range expected {
+		if record[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, record[key])
+		}
+	}
+	if _, ok := record["duration"]; !ok {
+		t.Error("Expected a duration")
+	}
+}
+
+func TestAccessLog_TraceID(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	handler := AccessLog(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	// Span já iniciado por tracing.Middleware
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/temperature", nil).WithContext(ctx))
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("Expected one JSON record, got %s", logs.String())
+	}
+	if record["trace_id"] != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected trace_id, got %v", record["trace_id"])
+	}
+}
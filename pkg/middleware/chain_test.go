@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func tag(name string, order *[]string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*order = append(*order, name)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestChainOrder(t *testing.T) {
+	var order []string
+	handler := Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, "handler")
+	}), tag("first", &order), tag("second", &order))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+
+	if got := strings.Join(order, ","); got != "first,second,handler" {
+		t.Errorf("Unexpected order: %s", got)
+	}
+}
+
+func TestResponseWriterKeepsFlusher(t *testing.T) {
+	var flushed bool
+	handler := Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("Expected the wrapped writer to implement http.Flusher")
+		}
+		w.Write([]byte("data: x\n\n"))
+		flusher.Flush()
+		flushed = true
+	}), AccessLog(discardLogger()), Recover(discardLogger()))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/temperature/stream", nil))
+	if !flushed || !rec.Flushed {
+		t.Error("Expected the response to be flushed")
+	}
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORS(t *testing.T) {
+	var called bool
+	config := CORSConfig{
+		AllowedOrigins: []string{"https://app.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"X-API-Key"},
+		ExposedHeaders: []string{"X-Request-ID"},
+		MaxAge:         10 * time.Minute,
+	}
+	handler := CORS(config)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	t.Run("preflight", func(t *testing.T) {
+		called = false
+		req := httptest.NewRequest(http.MethodOptions, "/temperature", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusNoContent || called {
+			t.Fatalf("Expected a 204 answered by the middleware, got %d (handler called: %v)", rec.Code, called)
+		}
+		h := rec.Header()
+		if h.Get("Access-Control-Allow-Origin") != "https://app.example.com" ||
+			h.Get("Access-Control-Allow-Methods") != "GET, POST" ||
+			h.Get("Access-Control-Allow-Headers") != "X-API-Key" ||
+			h.Get("Access-Control-Max-Age") != "600" {
+			t.Errorf("Unexpected headers: %v", h)
+		}
+	})
+
+	t.Run("actual request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/temperature", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Header().Get("Access-Control-Allow-Origin") != "https://app.example.com" || rec.Header().Get("Access-Control-Expose-Headers") != "X-Request-ID" {
+			t.Errorf("Unexpected headers: %v", rec.Header())
+		}
+		if rec.Header().Get("Vary") != "Origin" {
+			t.Errorf("Expected Vary: Origin, got %q", rec.Header().Get("Vary"))
+		}
+	})
+
+	t.Run("other origin", func(t *testing.T) {
+		called = false
+		req := httptest.NewRequest(http.MethodOptions, "/temperature", nil)
+		req.Header.Set("Origin", "https://evil.example.com")
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Header().Get("Access-Control-Allow-Origin") != "" || !called {
+			t.Errorf("Expected the request to pass through without CORS headers, got %v", rec.Header())
+		}
+	})
+}
+
+func TestCORS_Wildcard(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		credentials bool
+		expected    string
+	}{
+		{false, "*"},
+		{true, "https://any.example.com"},
+	}
+	for _, tt := range tests {
+		handler := CORS(CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: tt.credentials})(next)
+		req := httptest.NewRequest(http.MethodGet, "/temperature", nil)
+		req.Header.Set("Origin", "https://any.example.com")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.expected {
+			t.Errorf("Credentials %v: expected %q, got %q", tt.credentials, tt.expected, got)
+		}
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	got := SplitList(" GET, ,POST ,")
+	if len(got) != 2 || got[0] != "GET" || got[1] != "POST" {
+		t.Errorf("Unexpected list: %q", got)
+	}
+}
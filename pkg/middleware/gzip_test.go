@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGzip(t *testing.T) {
+	body := strings.Repeat(`{"temp_C":25,"temp_F":77,"temp_K":298.15}`, 20)
+	handler := Gzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"abc"`)
+		w.Write([]byte(body))
+	}))
+
+	req := httptest.NewRequest("GET", "/temperature", nil)
+	req.Header.Set("Accept-Encoding", "br, gzip;q=0.8")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Header().Get("Content-Encoding") != "gzip" || rec.Header().Get("Vary") != "Accept-Encoding" {
+		t.Fatalf("Unexpected headers: %v", rec.Header())
+	}
+	if rec.Header().Get("ETag") != `W/"abc"` {
+		t.Errorf("Expected the ETag to become weak, got %q", rec.Header().Get("ETag"))
+	}
+	if rec.Body.Len() >= len(body) {
+		t.Errorf("Expected a compressed body, got %d bytes", rec.Body.Len())
+	}
+	reader, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("Invalid gzip stream: %v", err)
+	}
+	decoded, _ := io.ReadAll(reader)
+	if string(decoded) != body {
+		t.Errorf("Unexpected body: %s", decoded)
+	}
+}
+
+func TestGzip_PassThrough(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		contentType    string
+		upgrade        bool
+	}{
+		{"not accepted", "", "application/json", false},
+		{"refused", "gzip;q=0", "application/json", false},
+		{"server-sent events", "gzip", "text/event-stream", false},
+		{"websocket", "gzip", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Gzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				w.Write([]byte("plain"))
+				if flusher, ok := w.(http.Flusher); ok {
+					flusher.Flush()
+				}
+			}))
+
+			req := httptest.NewRequest("GET", "/temperature/stream", nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			if tt.upgrade {
+				req.Header.Set("Upgrade", "websocket")
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Header().Get("Content-Encoding") != "" || rec.Body.String() != "plain" {
+				t.Errorf("Expected an uncompressed response, got %v %q", rec.Header(), rec.Body.String())
+			}
+		})
+	}
+}
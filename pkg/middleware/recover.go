@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recover turns a panicking handler into a 500 ErrorResponse and logs the
+// panic with its stack. If the handler had already started the response
+// the connection is left to be closed by net/http. http.ErrAbortHandler is
+// re-raised untouched.
+func Recover(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := wrap(w)
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if recovered == http.ErrAbortHandler {
+					panic(recovered)
+				}
+
+				logger.Error("panic",
+					"request_id", RequestIDFromContext(r.Context()),
+					"method", r.Method,
+					"path", r.URL.Path,
+					"panic", recovered,
+					"stack", string(debug.Stack()),
+				)
+				if rw.Status() != 0 {
+					panic(http.ErrAbortHandler)
+				}
+				writeError(rw, r, http.StatusInternalServerError, "internal server error")
+			}()
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{"generated", "", false},
+		{"propagated", "abc-123", true},
+		{"too long", strings.Repeat("a", 129), false},
+		{"control characters", "abc\x01", false},
+		{"spaces", "abc 123", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/temperature", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			id := rec.Header().Get(RequestIDHeader)
+			if id == "" || id != seen {
+				t.Fatalf("Expected the same ID in the header and context, got %q and %q", id, seen)
+			}
+			if tt.keep && id != tt.incoming {
+				t.Errorf("Expected %q to be propagated, got %q", tt.incoming, id)
+			}
+			if !tt.keep && (id == tt.incoming || len(id) != 32) {
+				t.Errorf("Expected a generated ID, got %q", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package openapi
+
+import (
+	"net/http"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/weather"
+)
+
+// Middleware rejects requests that do not match the specification with
+// 400 and an ErrorResponse in the negotiated format.
+func (h *Handler) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := h.Validate(r); err != nil {
+			format, negotiateErr := render.Negotiate(r)
+			if negotiateErr != nil {
+				format = render.JSON
+			}
+			weather.WriteError(w, r, format, http.StatusBadRequest, validationMessage(err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
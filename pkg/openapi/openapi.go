@@ -0,0 +1,122 @@
+// Package openapi publishes the OpenAPI 3 description of the HTTP API
+// (openapi.yaml, embedded in the binary) and validates requests against it.
+package openapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+//go:embed openapi.yaml
+var specYAML []byte
+
+// Load parses and validates the embedded specification.
+func Load() (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(specYAML)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid specification: %w", err)
+	}
+	return doc, nil
+}
+
+// Handler serves the specification and Swagger UI, and provides the
+// request validation middleware.
+type Handler struct {
+	Doc *openapi3.T
+
+	spec   []byte
+	router routers.Router
+}
+
+func NewHandler() (*Handler, error) {
+	doc, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	spec, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{Doc: doc, spec: spec, router: router}, nil
+}
+
+// Register mounts the documentation on mux:
+//
+//	GET /openapi.json
+//	GET /docs
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /openapi.json", h.serveSpec)
+	mux.HandleFunc("GET /docs", serveDocs)
+}
+
+func (h *Handler) serveSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(h.spec)
+}
+
+// Validate checks r against the operation it matches in the
+// specification. Requests for paths or methods the specification does not
+// describe are not validated and return nil.
+func (h *Handler) Validate(r *http.Request) error {
+	route, pathParams, err := h.router.FindRoute(r)
+	if err != nil {
+		return nil
+	}
+
+	// Handlers decode bodies as JSON whatever the Content-Type (curl -d
+	// sends form encoding), so bodies are validated as JSON as well. The
+	// validator buffers the body it reads into the clone.
+	req := r
+	if route.Operation.RequestBody != nil {
+		req = r.Clone(r.Context())
+		req.Header.Set("Content-Type", "application/json")
+		defer func() { r.Body = req.Body }()
+	}
+
+	return openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			// Authentication is enforced by auth.Middleware.
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			SkipSettingDefaults: true,
+		},
+	})
+}
+
+// validationMessage turns a validation error into an ErrorResponse
+// message such as "invalid parameter: cep".
+func validationMessage(err error) string {
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return "invalid request"
+	}
+	switch {
+	case requestErr.Parameter != nil:
+		return "invalid parameter: " + requestErr.Parameter.Name
+	case requestErr.RequestBody != nil:
+		var schemaErr *openapi3.SchemaError
+		if errors.As(err, &schemaErr) && len(schemaErr.JSONPointer()) > 0 {
+			return "invalid request body: " + schemaErr.JSONPointer()[0]
+		}
+		return "invalid request body"
+	}
+	return "invalid request"
+}
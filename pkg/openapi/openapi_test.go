@@ -0,0 +1,163 @@
+package openapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"temperature_server/pkg/weather"
+	"testing"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	h, err := NewHandler()
+	if err != nil {
+		t.Fatalf("NewHandler() error: %v", err)
+	}
+	return h
+}
+
+func TestSpecEndpoint(t *testing.T) {
+	h := newTestHandler(t)
+	mux := http.NewServeMux()
+	h.Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected Content-Type application/json, got %q", ct)
+	}
+	var spec struct {
+		OpenAPI string                     `json:"openapi"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("failed to decode spec: %v", err)
+	}
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		t.Errorf("expected OpenAPI 3, got %q", spec.OpenAPI)
+	}
+	if _, ok := spec.Paths["/temperature"]; !ok {
+		t.Error("expected /temperature in paths")
+	}
+}
+
+func TestDocsEndpoint(t *testing.T) {
+	h := newTestHandler(t)
+	mux := http.NewServeMux()
+	h.Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("expected HTML, got %q", ct)
+	}
+	if !strings.Contains(rec.Body.String(), `url: "/openapi.json"`) {
+		t.Error("expected Swagger UI to load /openapi.json")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	h := newTestHandler(t)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	})
+	handler := h.Middleware(next)
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		body        string
+		header      http.Header
+		wantStatus  int
+		wantMessage string
+		wantBody    string
+	}{
+		{name: "valid", method: http.MethodGet, target: "/temperature?cep=35630-016", wantStatus: http.StatusOK},
+		{name: "missing cep", method: http.MethodGet, target: "/weather", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: cep"},
+		{name: "malformed cep", method: http.MethodGet, target: "/indices?cep=abc", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: cep"},
+		{name: "unknown units", method: http.MethodGet, target: "/weather?cep=35630016&units=kelvin", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: units"},
+		{name: "hour out of range", method: http.MethodGet, target: "/temperature?cep=35630016&date=2025-07-01&hour=24", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: hour"},
+		{name: "translated", method: http.MethodGet, target: "/weather?lang=pt-BR", wantStatus: http.StatusBadRequest, wantMessage: "parâmetro inválido: cep"},
+		{name: "undocumented path", method: http.MethodGet, target: "/debug/vars", wantStatus: http.StatusOK},
+		{name: "undocumented method", method: http.MethodPut, target: "/temperature", wantStatus: http.StatusOK},
+		{
+			name: "valid body", method: http.MethodPost, target: "/alert-rules",
+			body:       `{"cep":"35630016","metric":"temp_c","operator":"<","threshold":2,"webhook_url":"https://example.com/hook"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"cep":"35630016","metric":"temp_c","operator":"<","threshold":2,"webhook_url":"https://example.com/hook"}`,
+		},
+		{
+			name: "form encoded body", method: http.MethodPost, target: "/admin/keys",
+			body:       `{"name":"app"}`,
+			header:     http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"name":"app"}`,
+		},
+		{
+			name: "unknown operator", method: http.MethodPost, target: "/alert-rules",
+			body:        `{"cep":"35630016","metric":"temp_c","operator":"!=","threshold":2,"webhook_url":"https://example.com/hook"}`,
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "invalid request body: operator",
+		},
+		{name: "negative limit", method: http.MethodPost, target: "/admin/keys", body: `{"name":"app","rate_limit":-1}`, wantStatus: http.StatusBadRequest, wantMessage: "invalid request body: rate_limit"},
+		{name: "malformed body", method: http.MethodPost, target: "/admin/keys", body: `{`, wantStatus: http.StatusBadRequest, wantMessage: "invalid request body"},
+		{name: "graphql query", method: http.MethodPost, target: "/graphql", body: `{"query":"{ convert(value: 1) { kelvin } }"}`, wantStatus: http.StatusOK},
+		{name: "graphql without query", method: http.MethodPost, target: "/graphql", body: `{"variables":{}}`, wantStatus: http.StatusBadRequest, wantMessage: "invalid request body: query"},
+		{name: "graphql get without query", method: http.MethodGet, target: "/graphql", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: query"},
+		{name: "reverse", method: http.MethodGet, target: "/reverse?lat=-19.72&lon=-45.25", wantStatus: http.StatusOK},
+		{name: "reverse without lon", method: http.MethodGet, target: "/reverse?lat=-19.72", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: lon"},
+		{name: "reverse latitude out of range", method: http.MethodGet, target: "/reverse?lat=-91&lon=-45.25", wantStatus: http.StatusBadRequest, wantMessage: "invalid parameter: lat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tt.wantMessage != "" {
+				var response weather.ErrorResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+					t.Fatalf("failed to decode error: %v", err)
+				}
+				if response.Error != tt.wantMessage {
+					t.Errorf("expected error %q, got %q", tt.wantMessage, response.Error)
+				}
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("expected the handler to read %q, got %q", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestMiddlewareErrorFormat(t *testing.T) {
+	h := newTestHandler(t)
+	handler := h.Middleware(http.NotFoundHandler())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/weather?format=xml", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.Contains(ct, "xml") {
+		t.Errorf("expected an XML error, got %q", ct)
+	}
+}
@@ -0,0 +1,188 @@
+package openapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"temperature_server/pkg/alerts"
+	"temperature_server/pkg/auth"
+	"temperature_server/pkg/calculations"
+	"temperature_server/pkg/geocode"
+	"temperature_server/pkg/graphql"
+	"temperature_server/pkg/history"
+	v1 "temperature_server/pkg/v1"
+	"temperature_server/pkg/watchlist"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	gql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// schemaTypes associa cada schema de openapi.yaml ao tipo Go que os
+// handlers codificam (ou decodificam, no caso das requisições).
+var schemaTypes = map[string]reflect.Type{
+	"ErrorResponse":             reflect.TypeOf(weather.ErrorResponse{}),
+	"TemperatureResponse":       reflect.TypeOf(weather.TemperatureResponse{}),
+	"HourlyTemperature":         reflect.TypeOf(weather.HourlyTemperature{}),
+	"DailyTemperatureResponse":  reflect.TypeOf(weather.DailyTemperatureResponse{}),
+	"HourlyTemperatureResponse": reflect.TypeOf(weather.HourlyTemperatureResponse{}),
+	"Measurement":               reflect.TypeOf(weather.Measurement{}),
+	"ConditionsResponse":        reflect.TypeOf(weather.ConditionsResponse{}),
+	"Index":                     reflect.TypeOf(calculations.Index{}),
+	"IndicesResponse":           reflect.TypeOf(weather.IndicesResponse{}),
+	"AirQualityCategory":        reflect.TypeOf(weather.AirQualityCategory{}),
+	"Pollutants":                reflect.TypeOf(weather.Pollutants{}),
+	"AirQualityResponse":        reflect.TypeOf(weather.AirQualityResponse{}),
+	"ActiveAlert":               reflect.TypeOf(weather.ActiveAlert{}),
+	"AlertsResponse":            reflect.TypeOf(weather.AlertsResponse{}),
+	"AstronomyResponse":         reflect.TypeOf(weather.AstronomyResponse{}),
+	"Bucket":                    reflect.TypeOf(history.Bucket{}),
+	"HistoryResponse":           reflect.TypeOf(history.HistoryResponse{}),
+	"CreateRuleRequest":         reflect.TypeOf(alerts.CreateRuleRequest{}),
+	"Rule":                      reflect.TypeOf(alerts.Rule{}),
+	"Delivery":                  reflect.TypeOf(alerts.Delivery{}),
+	"QuotaStatus":               reflect.TypeOf(watchlist.QuotaStatus{}),
+	"RunStatus":                 reflect.TypeOf(watchlist.RunStatus{}),
+	"EntryStatus":               reflect.TypeOf(watchlist.EntryStatus{}),
+	"WatchlistStatus":           reflect.TypeOf(watchlist.Status{}),
+	"CreateKeyRequest":          reflect.TypeOf(auth.CreateKeyRequest{}),
+	"Key":                       reflect.TypeOf(auth.Key{}),
+	"KeyWithSecret":             reflect.TypeOf(auth.CreateKeyResponse{}),
+	"UsageEntry":                reflect.TypeOf(auth.UsageEntry{}),
+	"KeyDetailsResponse":        reflect.TypeOf(auth.KeyDetailsResponse{}),
+	"Location":                  reflect.TypeOf(v1.Location{}),
+	"CacheStatus":               reflect.TypeOf(v1.CacheStatus{}),
+	"TemperatureEnvelope":       reflect.TypeOf(v1.Response{}),
+	"GraphQLRequest":            reflect.TypeOf(graphql.Request{}),
+	"GraphQLResponse":           reflect.TypeOf(gql.Response{}),
+	"GraphQLError":              reflect.TypeOf(gqlerrors.QueryError{}),
+	"GraphQLErrorLocation":      reflect.TypeOf(gqlerrors.Location{}),
+	"ReverseResponse":           reflect.TypeOf(geocode.ReverseResponse{}),
+}
+
+// requestSchemas descrevem apenas entradas, em que qualquer campo pode faltar.
+var requestSchemas = map[string]bool{"CreateRuleRequest": true, "CreateKeyRequest": true, "GraphQLRequest": true}
+
+// jsonFields lista os campos JSON que encoding/json gera para t e se cada
+// um está sempre presente. Structs embutidas são achatadas e omitempty
+// nunca omite uma struct que não é ponteiro, como time.Time.
+func jsonFields(t reflect.Type, fields map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name, options, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		if field.Anonymous && tag == "" {
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			jsonFields(fieldType, fields)
+			continue
+		}
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		omitempty := strings.Contains(options, "omitempty")
+		fields[name] = !omitempty || fieldType.Kind() == reflect.Struct
+	}
+}
+
+// schemaFields achata as propriedades de s e dos membros de allOf.
+func schemaFields(s *openapi3.Schema, fields map[string]bool) {
+	for _, member := range s.AllOf {
+		schemaFields(member.Value, fields)
+	}
+	for name := range s.Properties {
+		fields[name] = false
+	}
+	for _, name := range s.Required {
+		fields[name] = true
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestLoad(t *testing.T) {
+	doc, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if doc.Paths.Find("/temperature") == nil {
+		t.Error("expected /temperature to be documented")
+	}
+}
+
+func TestSchemasMatchGoTypes(t *testing.T) {
+	doc, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	for name, ref := range doc.Components.Schemas {
+		// Uniões como TemperatureResult são conferidas pelos seus membros
+		if len(ref.Value.AnyOf) > 0 {
+			continue
+		}
+		if _, ok := schemaTypes[name]; !ok {
+			t.Errorf("schema %s has no Go type in schemaTypes", name)
+		}
+	}
+
+	for name, goType := range schemaTypes {
+		ref, ok := doc.Components.Schemas[name]
+		if !ok {
+			t.Errorf("schema %s is not in openapi.yaml", name)
+			continue
+		}
+		want := map[string]bool{}
+		jsonFields(goType, want)
+		got := map[string]bool{}
+		schemaFields(ref.Value, got)
+
+		if !reflect.DeepEqual(sortedKeys(got), sortedKeys(want)) {
+			t.Errorf("schema %s properties = %v, %s fields = %v", name, sortedKeys(got), goType, sortedKeys(want))
+			continue
+		}
+		if requestSchemas[name] {
+			continue
+		}
+		for field, always := range want {
+			if got[field] != always {
+				t.Errorf("schema %s: %s required = %v, want %v", name, field, got[field], always)
+			}
+		}
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	type inner struct {
+		A string `json:"a"`
+	}
+	type sample struct {
+		inner
+		B string    `json:"b,omitempty"`
+		C time.Time `json:"c,omitempty"`
+		D string    `json:"-"`
+		E int
+	}
+
+	got := map[string]bool{}
+	jsonFields(reflect.TypeOf(sample{}), got)
+	want := map[string]bool{"a": true, "b": false, "c": true, "E": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jsonFields() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Bucket is a token bucket refilled at Rate tokens per second up to Burst.
+type Bucket struct {
+	Rate  float64
+	Burst int
+
+	mu       sync.Mutex
+	tokens   float64
+	last     time.Time
+	allowed  uint64
+	queued   uint64
+	rejected uint64
+}
+
+func NewBucket(rate float64, burst int) *Bucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Bucket{Rate: rate, Burst: burst, tokens: float64(burst)}
+}
+
+func (b *Bucket) refill(now time.Time) {
+	if b.last.IsZero() {
+		b.last = now
+		return
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(b.Burst), b.tokens+elapsed.Seconds()*b.Rate)
+		b.last = now
+	}
+}
+
+// Reserve takes a token for a request made at now. When the bucket is empty
+// the token is borrowed from the refill and wait is how long the request
+// must be held; if that exceeds maxWait nothing is taken, ok is false and
+// wait is the delay until a token would be free.
+func (b *Bucket) Reserve(now time.Time, maxWait time.Duration) (wait time.Duration, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		b.allowed++
+		return 0, true
+	}
+
+	wait = time.Duration((1 - b.tokens) / b.Rate * float64(time.Second))
+	if wait > maxWait {
+		b.rejected++
+		return wait, false
+	}
+	b.tokens--
+	b.queued++
+	return wait, true
+}
+
+// full reports whether the bucket has refilled completely, making it
+// indistinguishable from a new one.
+func (b *Bucket) full(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	return b.tokens >= float64(b.Burst)
+}
+
+type BucketStats struct {
+	Rate     float64 `json:"rate"`
+	Burst    int     `json:"burst"`
+	Tokens   float64 `json:"tokens"`
+	Allowed  uint64  `json:"allowed"`
+	Queued   uint64  `json:"queued"`
+	Rejected uint64  `json:"rejected"`
+}
+
+func (b *Bucket) Stats(now time.Time) BucketStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	return BucketStats{
+		Rate:     b.Rate,
+		Burst:    b.Burst,
+		Tokens:   b.tokens,
+		Allowed:  b.allowed,
+		Queued:   b.queued,
+		Rejected: b.rejected,
+	}
+}
+
+// Sleep holds a queued request for d, returning early with the context's
+// error if it is cancelled first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RetryAfter formats d as the whole seconds of a Retry-After header.
+func RetryAfter(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
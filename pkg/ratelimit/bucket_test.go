@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketReserve(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	b := NewBucket(2, 2)
+
+	for i := 0; i < 2; i++ {
+		if wait, ok := b.Reserve(now, 0); !ok || wait != 0 {
+			t.Fatalf("Request %d: expected immediate token, got %v %v", i, wait, ok)
+		}
+	}
+
+	if wait, ok := b.Reserve(now, 100*time.Millisecond); ok || wait != 500*time.Millisecond {
+		t.Errorf("Expected rejection with 500ms wait, got %v %v", wait, ok)
+	}
+
+	if wait, ok := b.Reserve(now, time.Second); !ok || wait != 500*time.Millisecond {
+		t.Errorf("Expected to queue for 500ms, got %v %v", wait, ok)
+	}
+	if wait, ok := b.Reserve(now, time.Second); !ok || wait != time.Second {
+		t.Errorf("Expected to queue behind the previous request for 1s, got %v %v", wait, ok)
+	}
+
+	stats := b.Stats(now.Add(2 * time.Second))
+	if stats.Allowed != 2 || stats.Queued != 2 || stats.Rejected != 1 {
+		t.Errorf("Unexpected counters: %+v", stats)
+	}
+	if stats.Tokens != 2 {
+		t.Errorf("Expected the bucket to refill up to burst, got %v", stats.Tokens)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                       "0",
+		200 * time.Millisecond:  "1",
+		time.Second:             "1",
+		1500 * time.Millisecond: "2",
+	}
+	for d, want := range tests {
+		if got := RetryAfter(d); got != want {
+			t.Errorf("RetryAfter(%v) = %s, want %s", d, got, want)
+		}
+	}
+}
+
+func TestLimiterPerKey(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	l := NewLimiter(1, 1, 0)
+
+	if _, ok := l.Reserve("10.0.0.1", now); !ok {
+		t.Fatal("Expected first request to pass")
+	}
+	if _, ok := l.Reserve("10.0.0.1", now); ok {
+		t.Error("Expected second request from the same client to be rejected")
+	}
+	if _, ok := l.Reserve("10.0.0.2", now); !ok {
+		t.Error("Expected another client to have its own bucket")
+	}
+
+	later := now.Add(2 * time.Minute)
+	l.Reserve("10.0.0.3", later)
+	stats := l.Stats(later)
+	if stats.Clients != 1 {
+		t.Errorf("Expected idle clients to be dropped, got %d", stats.Clients)
+	}
+	if stats.Allowed != 3 || stats.Rejected != 1 {
+		t.Errorf("Expected counters to survive eviction, got %+v", stats)
+	}
+}
@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle client buckets are dropped.
+const sweepInterval = time.Minute
+
+// Limiter keeps one Bucket per client key, such as an IP address.
+type Limiter struct {
+	Rate    float64
+	Burst   int
+	MaxWait time.Duration
+
+	mu        sync.Mutex
+	clients   map[string]*Bucket
+	lastSweep time.Time
+	evicted   BucketStats
+}
+
+func NewLimiter(rate float64, burst int, maxWait time.Duration) *Limiter {
+	return &Limiter{Rate: rate, Burst: burst, MaxWait: maxWait, clients: map[string]*Bucket{}}
+}
+
+func (l *Limiter) bucket(key string, now time.Time) *Bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.lastSweep = now
+		for k, b := range l.clients {
+			if b.full(now) {
+				stats := b.Stats(now)
+				l.evicted.Allowed += stats.Allowed
+				l.evicted.Queued += stats.Queued
+				l.evicted.Rejected += stats.Rejected
+				delete(l.clients, k)
+			}
+		}
+	}
+
+	b, ok := l.clients[key]
+	if !ok {
+		b = NewBucket(l.Rate, l.Burst)
+		l.clients[key] = b
+	}
+	return b
+}
+
+// Reserve charges one request to key; see Bucket.Reserve.
+func (l *Limiter) Reserve(key string, now time.Time) (time.Duration, bool) {
+	return l.bucket(key, now).Reserve(now, l.MaxWait)
+}
+
+type LimiterStats struct {
+	Rate     float64 `json:"rate"`
+	Burst    int     `json:"burst"`
+	Clients  int     `json:"clients"`
+	Allowed  uint64  `json:"allowed"`
+	Queued   uint64  `json:"queued"`
+	Rejected uint64  `json:"rejected"`
+}
+
+// Stats totals the counters of every client seen since startup.
+func (l *Limiter) Stats(now time.Time) LimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats := LimiterStats{
+		Rate:     l.Rate,
+		Burst:    l.Burst,
+		Clients:  len(l.clients),
+		Allowed:  l.evicted.Allowed,
+		Queued:   l.evicted.Queued,
+		Rejected: l.evicted.Rejected,
+	}
+	for _, b := range l.clients {
+		s := b.Stats(now)
+		stats.Allowed += s.Allowed
+		stats.Queued += s.Queued
+		stats.Rejected += s.Rejected
+	}
+	return stats
+}
@@ -0,0 +1,38 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/netip"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	lang := i18n.FromRequest(r)
+	w.Header().Set("Content-Language", lang)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(weather.ErrorResponse{Error: i18n.Message(lang, message)})
+}
+
+// Middleware limits each client IP (see ClientIP) with limiter. Requests
+// over the limit wait up to limiter.MaxWait for a token and are otherwise
+// answered with 429 and Retry-After.
+func Middleware(limiter *Limiter, trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			wait, ok := limiter.Reserve(ClientIP(r, trusted), time.Now())
+			if !ok {
+				w.Header().Set("Retry-After", RetryAfter(wait))
+				writeError(w, r, http.StatusTooManyRequests, "rate limit exceeded")
+				return
+			}
+			if err := Sleep(r.Context(), wait); err != nil {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
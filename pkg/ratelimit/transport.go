@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LimitError is returned by Transport when an upstream's bucket would make
+// the request wait longer than MaxWait.
+type LimitError struct {
+	Host string
+	Wait time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return "upstream rate limit exceeded: " + e.Host
+}
+
+// RetryAfter is how long until the upstream accepts requests again.
+func (e *LimitError) RetryAfter() time.Duration {
+	return e.Wait
+}
+
+// Transport shares one Bucket per upstream host across every outgoing
+// request, so bursts of traffic are smoothed to what each upstream allows.
+// Hosts without a limit pass straight through.
+type Transport struct {
+	Base    http.RoundTripper
+	MaxWait time.Duration
+
+	mu      sync.RWMutex
+	buckets map[string]*Bucket
+}
+
+func NewTransport(base http.RoundTripper, maxWait time.Duration) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, MaxWait: maxWait, buckets: map[string]*Bucket{}}
+}
+
+// Limit caps requests to host at rate per second with the given burst. A
+// rate of zero removes the limit.
+func (t *Transport) Limit(host string, rate float64, burst int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if rate <= 0 {
+		delete(t.buckets, host)
+		return
+	}
+	t.buckets[host] = NewBucket(rate, burst)
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.RLock()
+	bucket := t.buckets[req.URL.Hostname()]
+	t.mu.RUnlock()
+
+	if bucket != nil {
+		wait, ok := bucket.Reserve(time.Now(), t.MaxWait)
+		if !ok {
+			return nil, &LimitError{Host: req.URL.Hostname(), Wait: wait}
+		}
+		if err := Sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+	return t.Base.RoundTrip(req)
+}
+
+type UpstreamStats struct {
+	Host string `json:"host"`
+	BucketStats
+}
+
+func (t *Transport) Stats(now time.Time) []UpstreamStats {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	stats := make([]UpstreamStats, 0, len(t.buckets))
+	for host, bucket := range t.buckets {
+		stats = append(stats, UpstreamStats{Host: host, BucketStats: bucket.Stats(now)})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Host < stats[j].Host })
+	return stats
+}
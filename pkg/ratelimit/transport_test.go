@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTransport(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+	u, _ := url.Parse(upstream.URL)
+
+	transport := NewTransport(nil, 0)
+	transport.Limit(u.Hostname(), 1, 1)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("First request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.Get(upstream.URL)
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("Expected a LimitError, got %v", err)
+	}
+	if limitErr.Host != u.Hostname() || limitErr.RetryAfter() <= 0 || limitErr.RetryAfter() > time.Second {
+		t.Errorf("Unexpected error: %+v", limitErr)
+	}
+
+	stats := transport.Stats(time.Now())
+	if len(stats) != 1 || stats[0].Allowed != 1 || stats[0].Rejected != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+
+	transport.Limit(u.Hostname(), 0, 0)
+	resp, err = client.Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Expected unlimited host to pass, got %v", err)
+	}
+	resp.Body.Close()
+}
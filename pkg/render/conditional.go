@@ -0,0 +1,81 @@
+package render
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Validators describe how long a response may be cached and when the data
+// behind it was produced.
+type Validators struct {
+	LastModified time.Time
+	MaxAge       time.Duration
+}
+
+// ETag returns a strong entity tag hashed from an encoded body.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		// If-None-Match uses the weak comparison (RFC 9110, 13.1.2).
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// notModified evaluates If-None-Match and, only when it is absent,
+// If-Modified-Since.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		return etagMatches(header, etag)
+	}
+	if header := r.Header.Get("If-Modified-Since"); header != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(header)
+		return err == nil && !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// WriteConditional encodes v as a 200 response carrying an ETag of the
+// encoded body, Last-Modified and Cache-Control from validators, and
+// answers 304 Not Modified instead when the request's conditional headers
+// match.
+func WriteConditional(w http.ResponseWriter, r *http.Request, f *Format, v interface{}, validators Validators) error {
+	var body bytes.Buffer
+	if err := f.Encode(&body, v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return err
+	}
+
+	etag := ETag(body.Bytes())
+	h := w.Header()
+	h.Set("ETag", etag)
+	if !validators.LastModified.IsZero() {
+		h.Set("Last-Modified", validators.LastModified.UTC().Format(http.TimeFormat))
+	}
+	if validators.MaxAge > 0 {
+		h.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(validators.MaxAge.Seconds())))
+	}
+
+	if notModified(r, etag, validators.LastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	h.Set("Content-Type", f.ContentType())
+	h.Set("Content-Length", strconv.Itoa(body.Len()))
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(body.Bytes())
+	return err
+}
@@ -0,0 +1,78 @@
+package render
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriteConditional(t *testing.T) {
+	lastModified := time.Date(2025, 7, 9, 21, 15, 0, 0, time.UTC)
+	validators := Validators{LastModified: lastModified, MaxAge: 5 * time.Minute}
+	response := sampleResponse{Temp_C: 25, Temp_F: 77, Temp_K: 298.15}
+
+	first := httptest.NewRecorder()
+	if err := WriteConditional(first, httptest.NewRequest("GET", "/", nil), JSON, response, validators); err != nil {
+		t.Fatalf("WriteConditional() error = %v", err)
+	}
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Body.Len() == 0 {
+		t.Fatalf("Unexpected first response: %d %v", first.Code, first.Header())
+	}
+	if got := first.Header().Get("Last-Modified"); got != "Wed, 09 Jul 2025 21:15:00 GMT" {
+		t.Errorf("Unexpected Last-Modified %q", got)
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, max-age=300" {
+		t.Errorf("Unexpected Cache-Control %q", got)
+	}
+
+	tests := []struct {
+		name   string
+		header map[string]string
+		status int
+	}{
+		{"matching etag", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{"weak etag in list", map[string]string{"If-None-Match": `"other", W/` + etag}, http.StatusNotModified},
+		{"wildcard", map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+		{"stale etag", map[string]string{"If-None-Match": `"other"`}, http.StatusOK},
+		{"etag wins over date", map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": "Wed, 09 Jul 2025 22:00:00 GMT"}, http.StatusOK},
+		{"same date", map[string]string{"If-Modified-Since": "Wed, 09 Jul 2025 21:15:00 GMT"}, http.StatusNotModified},
+		{"older date", map[string]string{"If-Modified-Since": "Wed, 09 Jul 2025 21:00:00 GMT"}, http.StatusOK},
+		{"invalid date", map[string]string{"If-Modified-Since": "yesterday"}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			WriteConditional(rec, req, JSON, response, validators)
+
+			if rec.Code != tt.status {
+				t.Fatalf("Expected status %d, got %d", tt.status, rec.Code)
+			}
+			if rec.Header().Get("ETag") != etag {
+				t.Errorf("Expected the same ETag, got %q", rec.Header().Get("ETag"))
+			}
+			if tt.status == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("Expected an empty 304 body, got %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWriteConditional_ETagPerFormat(t *testing.T) {
+	response := sampleResponse{Temp_C: 25}
+	jsonRec, csvRec := httptest.NewRecorder(), httptest.NewRecorder()
+	WriteConditional(jsonRec, httptest.NewRequest("GET", "/", nil), JSON, response, Validators{})
+	WriteConditional(csvRec, httptest.NewRequest("GET", "/", nil), CSV, response, Validators{})
+
+	if jsonRec.Header().Get("ETag") == csvRec.Header().Get("ETag") {
+		t.Error("Expected different representations to have different ETags")
+	}
+	if jsonRec.Header().Get("Cache-Control") != "" || jsonRec.Header().Get("Last-Modified") != "" {
+		t.Errorf("Expected no Cache-Control or Last-Modified without validators, got %v", jsonRec.Header())
+	}
+}
@@ -0,0 +1,251 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Texter lets a response provide its own human-readable text form.
+type Texter interface {
+	Text() string
+}
+
+var (
+	JSON = &Format{Name: "json", MediaTypes: []string{"application/json"}, Encode: encodeJSON}
+	XML  = &Format{Name: "xml", MediaTypes: []string{"application/xml", "text/xml"}, Encode: encodeXML}
+	CSV  = &Format{Name: "csv", MediaTypes: []string{"text/csv"}, Encode: encodeCSV}
+	YAML = &Format{Name: "yaml", MediaTypes: []string{"application/yaml", "application/x-yaml", "text/yaml"}, Encode: encodeYAML}
+	Text = &Format{Name: "text", MediaTypes: []string{"text/plain"}, Encode: encodeText}
+)
+
+func init() {
+	Register(JSON)
+	Register(XML)
+	Register(CSV)
+	Register(YAML)
+	Register(Text)
+}
+
+func encodeJSON(w io.Writer, v interface{}) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// toNode goes through encoding/json so every format follows the json
+// struct tags and field order already declared on the response types.
+func toNode(v interface{}) (*yaml.Node, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	node := &doc
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	resetStyle(node)
+	return node, nil
+}
+
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+func encodeYAML(w io.Writer, v interface{}) error {
+	node, err := toNode(v)
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// rootName derives the XML root element from the Go type name, so
+// TemperatureResponse becomes <temperature>.
+func rootName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" {
+		return "response"
+	}
+	name := strings.TrimSuffix(t.Name(), "Response")
+	if name == "" {
+		return "response"
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func encodeXML(w io.Writer, v interface{}) error {
+	node, err := toNode(v)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := writeXMLNode(encoder, rootName(v), node); err != nil {
+		return err
+	}
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+func writeXMLNode(encoder *xml.Encoder, name string, node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		start := xml.StartElement{Name: xml.Name{Local: name}}
+		if err := encoder.EncodeToken(start); err != nil {
+			return err
+		}
+		for _, item := range node.Content {
+			if err := writeXMLNode(encoder, "item", item); err != nil {
+				return err
+			}
+		}
+		return encoder.EncodeToken(start.End())
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if err := writeXMLNode(encoder, node.Content[i].Value, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		if node.Tag != "!!null" {
+			if err := encoder.EncodeToken(xml.CharData(node.Value)); err != nil {
+				return err
+			}
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+type field struct {
+	key   string
+	value string
+}
+
+// flatten turns nested objects into dotted keys, e.g. heat_index.value_C.
+func flatten(prefix string, node *yaml.Node, out []field) []field {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			out = flatten(join(node.Content[i].Value), node.Content[i+1], out)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			out = flatten(join(strconv.Itoa(i)), item, out)
+		}
+	default:
+		value := node.Value
+		if node.Tag == "!!null" {
+			value = ""
+		}
+		out = append(out, field{key: prefix, value: value})
+	}
+	return out
+}
+
+func encodeCSV(w io.Writer, v interface{}) error {
+	node, err := toNode(v)
+	if err != nil {
+		return err
+	}
+
+	items := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		items = node.Content
+	}
+
+	var header []string
+	index := map[string]int{}
+	rows := make([]map[string]string, 0, len(items))
+	for _, item := range items {
+		row := map[string]string{}
+		for _, f := range flatten("", item, nil) {
+			key := f.key
+			if key == "" {
+				key = "value"
+			}
+			if _, ok := index[key]; !ok {
+				index[key] = len(header)
+				header = append(header, key)
+			}
+			row[key] = f.value
+		}
+		rows = append(rows, row)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, key := range header {
+			record[i] = row[key]
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func encodeText(w io.Writer, v interface{}) error {
+	if texter, ok := v.(Texter); ok {
+		_, err := fmt.Fprintln(w, texter.Text())
+		return err
+	}
+
+	node, err := toNode(v)
+	if err != nil {
+		return err
+	}
+	for _, f := range flatten("", node, nil) {
+		if _, err := fmt.Fprintf(w, "%s: %s\n", f.key, f.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
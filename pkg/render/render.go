@@ -0,0 +1,172 @@
+package render
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var ErrNotAcceptable = errors.New("not acceptable")
+
+type Format struct {
+	// Name is the value accepted by the ?format= query parameter.
+	Name string
+	// MediaTypes are matched against the Accept header; the first one is
+	// sent back as the Content-Type.
+	MediaTypes []string
+	Encode     func(w io.Writer, v interface{}) error
+}
+
+func (f *Format) ContentType() string {
+	if strings.HasPrefix(f.MediaTypes[0], "text/") {
+		return f.MediaTypes[0] + "; charset=utf-8"
+	}
+	return f.MediaTypes[0]
+}
+
+var (
+	mu      sync.RWMutex
+	formats []*Format
+)
+
+// Register adds a format to the registry. Formats registered first win
+// when the client has no preference, so JSON is registered first.
+func Register(f *Format) {
+	mu.Lock()
+	defer mu.Unlock()
+	for i, existing := range formats {
+		if existing.Name == f.Name {
+			formats[i] = f
+			return
+		}
+	}
+	formats = append(formats, f)
+}
+
+func Lookup(name string) (*Format, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	name = strings.ToLower(name)
+	for _, f := range formats {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+func Formats() []*Format {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]*Format(nil), formats...)
+}
+
+type acceptRange struct {
+	mediaType string
+	q         float64
+}
+
+func parseAccept(header string) []acceptRange {
+	var ranges []acceptRange
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+		ranges = append(ranges, acceptRange{mediaType: mediaType, q: q})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return specificity(ranges[i].mediaType) > specificity(ranges[j].mediaType)
+	})
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].q > ranges[j].q
+	})
+	return ranges
+}
+
+func specificity(mediaType string) int {
+	switch {
+	case mediaType == "*/*":
+		return 0
+	case strings.HasSuffix(mediaType, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+func matches(pattern, mediaType string) bool {
+	if pattern == "*/*" || pattern == mediaType {
+		return true
+	}
+	if strings.HasSuffix(pattern, "/*") {
+		return strings.HasPrefix(mediaType, strings.TrimSuffix(pattern, "*"))
+	}
+	return false
+}
+
+// Negotiate picks the response format for r. The ?format= query parameter
+// takes precedence over the Accept header; with neither, the first
+// registered format is used.
+func Negotiate(r *http.Request) (*Format, error) {
+	if name := r.URL.Query().Get("format"); name != "" {
+		if f, ok := Lookup(name); ok {
+			return f, nil
+		}
+		return nil, ErrNotAcceptable
+	}
+
+	available := Formats()
+	if len(available) == 0 {
+		return nil, ErrNotAcceptable
+	}
+
+	header := r.Header.Get("Accept")
+	if strings.TrimSpace(header) == "" {
+		return available[0], nil
+	}
+
+	// Explicitly refused types (q=0) must not be chosen through a wildcard.
+	refused := map[string]bool{}
+	ranges := parseAccept(header)
+	for _, ar := range ranges {
+		if ar.q <= 0 && specificity(ar.mediaType) == 2 {
+			refused[ar.mediaType] = true
+		}
+	}
+
+	for _, ar := range ranges {
+		if ar.q <= 0 {
+			continue
+		}
+		for _, f := range available {
+			for _, mediaType := range f.MediaTypes {
+				if !refused[mediaType] && matches(ar.mediaType, mediaType) {
+					return f, nil
+				}
+			}
+		}
+	}
+	return nil, ErrNotAcceptable
+}
+
+func Write(w http.ResponseWriter, f *Format, status int, v interface{}) error {
+	w.Header().Set("Content-Type", f.ContentType())
+	w.WriteHeader(status)
+	return f.Encode(w, v)
+}
@@ -0,0 +1,162 @@
+package render
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type sampleResponse struct {
+	Temp_C float64 `json:"temp_C"`
+	Temp_F float64 `json:"temp_F"`
+	Temp_K float64 `json:"temp_K"`
+}
+
+type nestedResponse struct {
+	City  string   `json:"city"`
+	Index index    `json:"heat_index"`
+	Tags  []string `json:"tags"`
+}
+
+type index struct {
+	ValueC float64 `json:"value_C"`
+	Risk   string  `json:"risk"`
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		query    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "sem Accept", expected: "json"},
+		{name: "qualquer tipo", accept: "*/*", expected: "json"},
+		{name: "json", accept: "application/json", expected: "json"},
+		{name: "xml", accept: "application/xml", expected: "xml"},
+		{name: "text/xml", accept: "text/xml", expected: "xml"},
+		{name: "csv", accept: "text/csv", expected: "csv"},
+		{name: "yaml", accept: "application/yaml", expected: "yaml"},
+		{name: "texto", accept: "text/plain", expected: "text"},
+		{name: "qualidade", accept: "application/json;q=0.5, text/csv;q=0.9", expected: "csv"},
+		{name: "curinga de texto", accept: "text/*", expected: "xml"},
+		{name: "mais específico primeiro", accept: "*/*, text/plain", expected: "text"},
+		{name: "tipo recusado", accept: "application/json;q=0, */*;q=0.1", expected: "xml"},
+		{name: "query sobrescreve Accept", accept: "application/xml", query: "yaml", expected: "yaml"},
+		{name: "não suportado", accept: "image/png", wantErr: true},
+		{name: "format desconhecido", query: "pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := "/temperature"
+			if tt.query != "" {
+				target += "?format=" + tt.query
+			}
+			r := httptest.NewRequest(http.MethodGet, target, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+
+			format, err := Negotiate(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Negotiate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && format.Name != tt.expected {
+				t.Errorf("Negotiate() = %s, expected %s", format.Name, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncoders(t *testing.T) {
+	response := sampleResponse{Temp_C: 25, Temp_F: 77, Temp_K: 298.15}
+
+	tests := []struct {
+		format   *Format
+		expected string
+	}{
+		{format: JSON, expected: `{"temp_C":25,"temp_F":77,"temp_K":298.15}` + "\n"},
+		{format: XML, expected: xmlHeader() + "<sample>\n  <temp_C>25</temp_C>\n  <temp_F>77</temp_F>\n  <temp_K>298.15</temp_K>\n</sample>\n"},
+		{format: CSV, expected: "temp_C,temp_F,temp_K\n25,77,298.15\n"},
+		{format: YAML, expected: "temp_C: 25\ntemp_F: 77\ntemp_K: 298.15\n"},
+		{format: Text, expected: "temp_C: 25\ntemp_F: 77\ntemp_K: 298.15\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.format.Encode(&buf, response); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("Encode() =\n%s\nexpected\n%s", buf.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncoders_Nested(t *testing.T) {
+	response := nestedResponse{City: "Abaeté", Index: index{ValueC: 31.5, Risk: "caution"}, Tags: []string{"a", "b"}}
+
+	var csvBuf bytes.Buffer
+	if err := CSV.Encode(&csvBuf, response); err != nil {
+		t.Fatalf("CSV error = %v", err)
+	}
+	expected := "city,heat_index.value_C,heat_index.risk,tags.0,tags.1\nAbaeté,31.5,caution,a,b\n"
+	if csvBuf.String() != expected {
+		t.Errorf("CSV =\n%s\nexpected\n%s", csvBuf.String(), expected)
+	}
+
+	var xmlBuf bytes.Buffer
+	if err := XML.Encode(&xmlBuf, response); err != nil {
+		t.Fatalf("XML error = %v", err)
+	}
+	for _, part := range []string{"<nested>", "<heat_index>", "<risk>caution</risk>", "<item>a</item>"} {
+		if !strings.Contains(xmlBuf.String(), part) {
+			t.Errorf("Expected XML to contain %s, got %s", part, xmlBuf.String())
+		}
+	}
+
+	var csvList bytes.Buffer
+	if err := CSV.Encode(&csvList, []sampleResponse{{Temp_C: 1}, {Temp_C: 2}}); err != nil {
+		t.Fatalf("CSV list error = %v", err)
+	}
+	if csvList.String() != "temp_C,temp_F,temp_K\n1,0,0\n2,0,0\n" {
+		t.Errorf("Unexpected CSV list: %s", csvList.String())
+	}
+}
+
+type texterResponse struct{}
+
+func (texterResponse) Text() string { return "custom text" }
+
+func TestText_Texter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Text.Encode(&buf, texterResponse{}); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if buf.String() != "custom text\n" {
+		t.Errorf("Expected Texter output, got %q", buf.String())
+	}
+}
+
+func TestWrite(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	if err := Write(recorder, CSV, http.StatusOK, sampleResponse{Temp_C: 1}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "text/csv; charset=utf-8" {
+		t.Errorf("Expected text/csv content type, got %s", contentType)
+	}
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", recorder.Code)
+	}
+}
+
+func xmlHeader() string {
+	return `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
+}
@@ -0,0 +1,264 @@
+package stream
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+const (
+	DefaultPollInterval = 30 * time.Second
+	historySize         = 16
+	subscriberBuffer    = 8
+)
+
+// Update is one observation published by a poller. ID is the provider's
+// LastUpdatedEpoch and doubles as the SSE event id.
+type Update struct {
+	ID      int64
+	Key     string
+	Weather *weather.WeatherResponse
+	Err     error
+}
+
+type Subscription struct {
+	C <-chan Update
+
+	ch     chan Update
+	done   chan struct{}
+	hub    *Hub
+	poller *poller
+	once   sync.Once
+}
+
+// Close detaches the subscription; the poller stops once its last
+// subscriber is gone.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.hub.unsubscribe(s)
+		close(s.done)
+	})
+}
+
+// Done is closed once the subscription has been closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+type poller struct {
+	key         string
+	subscribers map[*Subscription]struct{}
+	history     []Update
+	lastErr     error
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+// Hub runs a single upstream poller per key (usually a CEP) and fans its
+// updates out to every subscriber of that key.
+type Hub struct {
+	Fetch    func(key string) (*weather.WeatherResponse, error)
+	Interval time.Duration
+
+	mu      sync.Mutex
+	pollers map[string]*poller
+}
+
+func NewHub(fetch func(key string) (*weather.WeatherResponse, error), interval time.Duration) *Hub {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &Hub{
+		Fetch:    fetch,
+		Interval: interval,
+		pollers:  map[string]*poller{},
+	}
+}
+
+func NormalizeKey(key string) string {
+	key = strings.ReplaceAll(key, "-", "")
+	key = strings.ReplaceAll(key, ".", "")
+	key = strings.ReplaceAll(key, " ", "")
+	return key
+}
+
+// CoordinatesKey rounds to four decimal places (about 11 m) so nearby
+// clients share a poller.
+func CoordinatesKey(lat, lon float64) string {
+	return fmt.Sprintf("%.4f,%.4f", lat, lon)
+}
+
+// FetchByKey is the Hub fetch function for keys built by NormalizeKey or
+// CoordinatesKey.
+func FetchByKey(key string) (*weather.WeatherResponse, error) {
+	lat, lon, found := strings.Cut(key, ",")
+	if !found {
+		return weather.GetWeatherByCEP(key)
+	}
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid coordinates: %w", err)
+	}
+	longitude, err := strconv.ParseFloat(lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid coordinates: %w", err)
+	}
+	return weather.FetchWeatherData(latitude, longitude)
+}
+
+// Subscribe starts receiving updates for key. Updates newer than
+// lastEventID that the poller still remembers are replayed first; with a
+// zero lastEventID only the latest reading is replayed.
+func (h *Hub) Subscribe(key string, lastEventID int64) *Subscription {
+	ch := make(chan Update, subscriberBuffer)
+	sub := &Subscription{C: ch, ch: ch, done: make(chan struct{}), hub: h}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.pollers[key]
+	if !ok {
+		p = &poller{
+			key:         key,
+			subscribers: map[*Subscription]struct{}{},
+			stop:        make(chan struct{}),
+			done:        make(chan struct{}),
+		}
+		h.pollers[key] = p
+		go h.run(p)
+	}
+	p.subscribers[sub] = struct{}{}
+	sub.poller = p
+
+	var replay []Update
+	if lastEventID > 0 {
+		for _, update := range p.history {
+			if update.ID > lastEventID {
+				replay = append(replay, update)
+			}
+		}
+	} else if len(p.history) > 0 {
+		replay = p.history[len(p.history)-1:]
+	}
+	for _, update := range replay {
+		deliver(sub.ch, update)
+	}
+
+	return sub
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p := sub.poller
+	delete(p.subscribers, sub)
+	if len(p.subscribers) == 0 && h.pollers[p.key] == p {
+		delete(h.pollers, p.key)
+		close(p.stop)
+	}
+}
+
+// Close stops every poller and waits for them to exit.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	pollers := h.pollers
+	h.pollers = map[string]*poller{}
+	for _, p := range pollers {
+		close(p.stop)
+	}
+	h.mu.Unlock()
+
+	for _, p := range pollers {
+		<-p.done
+	}
+}
+
+// Pollers returns how many upstream pollers are running.
+func (h *Hub) Pollers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.pollers)
+}
+
+// Latest returns the most recent update seen for key, if any poller is
+// running for it.
+func (h *Hub) Latest(key string) (Update, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, ok := h.pollers[key]
+	if !ok || len(p.history) == 0 {
+		return Update{}, false
+	}
+	return p.history[len(p.history)-1], true
+}
+
+func (h *Hub) run(p *poller) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(h.Interval)
+	defer ticker.Stop()
+
+	for {
+		h.poll(p)
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Hub) poll(p *poller) {
+	weatherData, err := h.Fetch(p.key)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err != nil {
+		// Only report an error once until it changes or a reading succeeds.
+		if p.lastErr == nil || p.lastErr.Error() != err.Error() {
+			p.lastErr = err
+			h.broadcast(p, Update{Key: p.key, Err: err})
+		}
+		return
+	}
+	p.lastErr = nil
+
+	id := weatherData.Current.LastUpdatedEpoch
+	if len(p.history) > 0 && p.history[len(p.history)-1].ID >= id {
+		return
+	}
+
+	update := Update{ID: id, Key: p.key, Weather: weatherData}
+	p.history = append(p.history, update)
+	if len(p.history) > historySize {
+		p.history = p.history[len(p.history)-historySize:]
+	}
+	h.broadcast(p, update)
+}
+
+func (h *Hub) broadcast(p *poller, update Update) {
+	for sub := range p.subscribers {
+		deliver(sub.ch, update)
+	}
+}
+
+// deliver never blocks the poller: when a subscriber falls behind, its
+// oldest pending update is dropped to make room for the newest.
+func deliver(ch chan Update, update Update) {
+	for {
+		select {
+		case ch <- update:
+			return
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
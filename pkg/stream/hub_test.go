@@ -0,0 +1,138 @@
+package stream
+
+import (
+	"sync"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+// fakeUpstream simula a WeatherAPI: a leitura atual pode ser trocada pelo
+// teste e as chamadas são contadas por chave.
+type fakeUpstream struct {
+	mu      sync.Mutex
+	current weather.Current
+	calls   map[string]int
+}
+
+func newFakeUpstream(epoch int64, tempC float64) *fakeUpstream {
+	return &fakeUpstream{
+		current: weather.Current{LastUpdatedEpoch: epoch, TempC: tempC},
+		calls:   map[string]int{},
+	}
+}
+
+func (f *fakeUpstream) Fetch(key string) (*weather.WeatherResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[key]++
+	return &weather.WeatherResponse{Current: f.current}, nil
+}
+
+func (f *fakeUpstream) set(epoch int64, tempC float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.current = weather.Current{LastUpdatedEpoch: epoch, TempC: tempC}
+}
+
+func (f *fakeUpstream) keys() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.calls)
+}
+
+func receive(t *testing.T, sub *Subscription) Update {
+	t.Helper()
+	select {
+	case update := <-sub.C:
+		return update
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for update")
+		return Update{}
+	}
+}
+
+func TestHub_SharesPollerPerKey(t *testing.T) {
+	upstream := newFakeUpstream(100, 20)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	first := hub.Subscribe(NormalizeKey("35630-016"), 0)
+	second := hub.Subscribe(NormalizeKey("35630016"), 0)
+
+	if hub.Pollers() != 1 {
+		t.Errorf("Expected 1 poller, got %d", hub.Pollers())
+	}
+
+	for _, sub := range []*Subscription{first, second} {
+		if update := receive(t, sub); update.ID != 100 {
+			t.Errorf("Expected update 100, got %d", update.ID)
+		}
+	}
+
+	upstream.set(200, 21)
+	for _, sub := range []*Subscription{first, second} {
+		if update := receive(t, sub); update.ID != 200 || update.Weather.Current.TempC != 21 {
+			t.Errorf("Expected update 200 at 21°C, got %d at %f", update.ID, update.Weather.Current.TempC)
+		}
+	}
+
+	if upstream.keys() != 1 {
+		t.Errorf("Expected a single upstream key, got %d", upstream.keys())
+	}
+}
+
+func TestHub_StopsPollerWhenLastSubscriberLeaves(t *testing.T) {
+	upstream := newFakeUpstream(100, 20)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	first := hub.Subscribe("35630016", 0)
+	second := hub.Subscribe("35630016", 0)
+
+	first.Close()
+	if hub.Pollers() != 1 {
+		t.Errorf("Expected poller to keep running, got %d pollers", hub.Pollers())
+	}
+
+	second.Close()
+	second.Close()
+	if hub.Pollers() != 0 {
+		t.Errorf("Expected poller to stop, got %d pollers", hub.Pollers())
+	}
+}
+
+func TestHub_ResumeFromLastEventID(t *testing.T) {
+	upstream := newFakeUpstream(100, 20)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	keeper := hub.Subscribe("35630016", 0)
+	receive(t, keeper)
+	upstream.set(200, 21)
+	receive(t, keeper)
+	upstream.set(300, 22)
+	receive(t, keeper)
+
+	// O cliente viu o evento 100 e deve receber 200 e 300
+	resumed := hub.Subscribe("35630016", 100)
+	for _, expected := range []int64{200, 300} {
+		if update := receive(t, resumed); update.ID != expected {
+			t.Errorf("Expected replayed update %d, got %d", expected, update.ID)
+		}
+	}
+}
+
+func TestDeliver_DropsOldestWhenFull(t *testing.T) {
+	ch := make(chan Update, 2)
+	for id := int64(1); id <= 4; id++ {
+		deliver(ch, Update{ID: id})
+	}
+
+	if first := <-ch; first.ID != 3 {
+		t.Errorf("Expected oldest remaining update 3, got %d", first.ID)
+	}
+	if second := <-ch; second.ID != 4 {
+		t.Errorf("Expected newest update 4, got %d", second.ID)
+	}
+}
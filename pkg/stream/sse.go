@@ -0,0 +1,124 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/utils"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+const DefaultHeartbeat = 15 * time.Second
+
+type TemperatureEvent struct {
+	CEP        string  `json:"cep"`
+	ObservedAt int64   `json:"observed_at"`
+	Temp_C     float64 `json:"temp_C"`
+	Temp_F     float64 `json:"temp_F"`
+	Temp_K     float64 `json:"temp_K"`
+}
+
+func NewTemperatureEvent(update Update) TemperatureEvent {
+	temperature := weather.NewTemperatureResponse(utils.FromCelsius(update.Weather.Current.TempC))
+	return TemperatureEvent{
+		CEP:        update.Key,
+		ObservedAt: update.ID,
+		Temp_C:     temperature.Temp_C,
+		Temp_F:     temperature.Temp_F,
+		Temp_K:     temperature.Temp_K,
+	}
+}
+
+type SSEHandler struct {
+	Hub       *Hub
+	Heartbeat time.Duration
+}
+
+func NewSSEHandler(hub *Hub, heartbeat time.Duration) *SSEHandler {
+	if heartbeat <= 0 {
+		heartbeat = DefaultHeartbeat
+	}
+	return &SSEHandler{Hub: hub, Heartbeat: heartbeat}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	lang := i18n.FromRequest(r)
+	w.Header().Set("Content-Language", lang)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(weather.ErrorResponse{Error: i18n.Message(lang, message)})
+}
+
+func writeEvent(w http.ResponseWriter, id int64, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+func (h *SSEHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, r, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	cep := NormalizeKey(r.URL.Query().Get("cep"))
+	if cep == "" {
+		writeError(w, r, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, r, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	var lastEventID int64
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		lastEventID, _ = strconv.ParseInt(header, 10, 64)
+	}
+
+	sub := h.Hub.Subscribe(cep, lastEventID)
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", h.Heartbeat.Milliseconds())
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(h.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			err = writeEvent(w, 0, "heartbeat", map[string]int64{"time": time.Now().Unix()})
+		case update := <-sub.C:
+			if update.Err != nil {
+				err = writeEvent(w, 0, "error", weather.ErrorResponse{Error: update.Err.Error()})
+			} else {
+				err = writeEvent(w, update.ID, "temperature", NewTemperatureEvent(update))
+			}
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
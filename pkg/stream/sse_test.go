@@ -0,0 +1,173 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+func readEvent(t *testing.T, reader *bufio.Reader) sseEvent {
+	t.Helper()
+	var ev sseEvent
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Failed to read event: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		if line == "" {
+			if ev.event != "" {
+				return ev
+			}
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			ev.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			ev.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ev.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func openStream(t *testing.T, ctx context.Context, url, lastEventID string) *http.Response {
+	t.Helper()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to open stream: %v", err)
+	}
+	return resp
+}
+
+func TestSSEHandler_StreamsUpdatesAndHeartbeats(t *testing.T) {
+	upstream := newFakeUpstream(100, 25)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	server := httptest.NewServer(NewSSEHandler(hub, 50*time.Millisecond))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp := openStream(t, ctx, server.URL+"/temperature/stream?cep=35630-016", "")
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("Expected text/event-stream, got %s", contentType)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	ev := readEvent(t, reader)
+	if ev.event != "temperature" || ev.id != "100" {
+		t.Fatalf("Expected temperature event 100, got %+v", ev)
+	}
+	if !strings.Contains(ev.data, `"temp_C":25`) || !strings.Contains(ev.data, `"cep":"35630016"`) {
+		t.Errorf("Unexpected event data: %s", ev.data)
+	}
+
+	upstream.set(200, 26)
+	sawHeartbeat := false
+	for {
+		ev = readEvent(t, reader)
+		if ev.event == "heartbeat" {
+			sawHeartbeat = true
+			continue
+		}
+		if ev.event == "temperature" && ev.id == "200" {
+			break
+		}
+	}
+
+	if !sawHeartbeat {
+		// Espera explícita por um heartbeat caso a atualização tenha chegado antes
+		if ev = readEvent(t, reader); ev.event != "heartbeat" {
+			t.Errorf("Expected heartbeat event, got %+v", ev)
+		}
+	}
+}
+
+func TestSSEHandler_ResumeWithLastEventID(t *testing.T) {
+	upstream := newFakeUpstream(100, 25)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	keeper := hub.Subscribe("35630016", 0)
+	defer keeper.Close()
+	receive(t, keeper)
+	upstream.set(200, 26)
+	receive(t, keeper)
+
+	server := httptest.NewServer(NewSSEHandler(hub, time.Second))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp := openStream(t, ctx, server.URL+"/temperature/stream?cep=35630016", "100")
+	defer resp.Body.Close()
+
+	ev := readEvent(t, bufio.NewReader(resp.Body))
+	if ev.id != "200" {
+		t.Errorf("Expected to resume at event 200, got %+v", ev)
+	}
+}
+
+func TestSSEHandler_TeardownOnDisconnect(t *testing.T) {
+	upstream := newFakeUpstream(100, 25)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	server := httptest.NewServer(NewSSEHandler(hub, time.Second))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resp := openStream(t, ctx, server.URL+"/temperature/stream?cep=35630016", "")
+	readEvent(t, bufio.NewReader(resp.Body))
+
+	if hub.Pollers() != 1 {
+		t.Fatalf("Expected 1 poller, got %d", hub.Pollers())
+	}
+
+	cancel()
+	resp.Body.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for hub.Pollers() != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if hub.Pollers() != 0 {
+		t.Errorf("Expected poller to stop after disconnect, got %d", hub.Pollers())
+	}
+}
+
+func TestSSEHandler_MissingCEP(t *testing.T) {
+	hub := NewHub(newFakeUpstream(100, 25).Fetch, time.Second)
+	defer hub.Close()
+
+	recorder := httptest.NewRecorder()
+	NewSSEHandler(hub, time.Second).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/temperature/stream", nil))
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", recorder.Code)
+	}
+	if !strings.Contains(recorder.Body.String(), "invalid zipcode") {
+		t.Errorf("Expected invalid zipcode error, got %s", recorder.Body.String())
+	}
+}
@@ -0,0 +1,276 @@
+package stream
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"temperature_server/pkg/utils"
+	"temperature_server/pkg/weather"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	DefaultMaxSubscriptions = 10
+	// maxQueuedMessages bounds control messages waiting to be written;
+	// temperature updates are coalesced per key and never grow the queue
+	// past the number of subscriptions.
+	maxQueuedMessages = 64
+	maxMessageSize    = 4096
+)
+
+type ClientMessage struct {
+	Action string   `json:"action"`
+	CEP    string   `json:"cep,omitempty"`
+	Lat    *float64 `json:"lat,omitempty"`
+	Lon    *float64 `json:"lon,omitempty"`
+}
+
+func (m ClientMessage) key() (string, bool) {
+	if m.CEP != "" {
+		key := NormalizeKey(m.CEP)
+		return key, key != ""
+	}
+	if m.Lat != nil && m.Lon != nil {
+		if *m.Lat < -90 || *m.Lat > 90 || *m.Lon < -180 || *m.Lon > 180 {
+			return "", false
+		}
+		return CoordinatesKey(*m.Lat, *m.Lon), true
+	}
+	return "", false
+}
+
+type ServerMessage struct {
+	Type       string `json:"type"`
+	Key        string `json:"key,omitempty"`
+	ObservedAt int64  `json:"observed_at,omitempty"`
+	Error      string `json:"error,omitempty"`
+	*weather.TemperatureResponse
+}
+
+type WebSocketHandler struct {
+	Hub              *Hub
+	MaxSubscriptions int
+	WriteTimeout     time.Duration
+	PingInterval     time.Duration
+	Upgrader         websocket.Upgrader
+}
+
+func NewWebSocketHandler(hub *Hub) *WebSocketHandler {
+	return &WebSocketHandler{
+		Hub:              hub,
+		MaxSubscriptions: DefaultMaxSubscriptions,
+		WriteTimeout:     10 * time.Second,
+		PingInterval:     30 * time.Second,
+	}
+}
+
+func (h *WebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &wsConn{
+		handler: h,
+		conn:    conn,
+		subs:    map[string]*Subscription{},
+		pending: map[string]int{},
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go c.writeLoop()
+	c.readLoop()
+}
+
+type wsConn struct {
+	handler *WebSocketHandler
+	conn    *websocket.Conn
+
+	mu    sync.Mutex
+	subs  map[string]*Subscription
+	queue []ServerMessage
+	// pending maps a key to the index of its queued temperature message,
+	// so a newer reading replaces one the client has not received yet.
+	pending map[string]int
+	closed  bool
+
+	notify    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *wsConn) close() {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		c.closed = true
+		subs := c.subs
+		c.subs = map[string]*Subscription{}
+		c.mu.Unlock()
+
+		for _, sub := range subs {
+			sub.Close()
+		}
+		close(c.done)
+		c.conn.Close()
+	})
+}
+
+func (c *wsConn) enqueue(msg ServerMessage) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	if msg.Type == "temperature" {
+		if index, ok := c.pending[msg.Key]; ok {
+			c.queue[index] = msg
+			c.mu.Unlock()
+			return
+		}
+		c.pending[msg.Key] = len(c.queue)
+	}
+	c.queue = append(c.queue, msg)
+	overflow := len(c.queue) > maxQueuedMessages
+	c.mu.Unlock()
+
+	if overflow {
+		c.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "slow consumer"),
+			time.Now().Add(time.Second))
+		c.close()
+		return
+	}
+
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *wsConn) writeLoop() {
+	ping := time.NewTicker(c.handler.PingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ping.C:
+			c.conn.SetWriteDeadline(time.Now().Add(c.handler.WriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close()
+				return
+			}
+		case <-c.notify:
+			c.mu.Lock()
+			queue := c.queue
+			c.queue = nil
+			c.pending = map[string]int{}
+			c.mu.Unlock()
+
+			for _, msg := range queue {
+				c.conn.SetWriteDeadline(time.Now().Add(c.handler.WriteTimeout))
+				if err := c.conn.WriteJSON(msg); err != nil {
+					c.close()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *wsConn) readLoop() {
+	defer c.close()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	readTimeout := 2 * c.handler.PingInterval
+	c.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		c.conn.SetReadDeadline(time.Now().Add(readTimeout))
+
+		var msg ClientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			c.enqueue(ServerMessage{Type: "error", Error: "invalid message"})
+			continue
+		}
+		c.handle(msg)
+	}
+}
+
+func (c *wsConn) handle(msg ClientMessage) {
+	key, ok := msg.key()
+	if !ok {
+		c.enqueue(ServerMessage{Type: "error", Error: "invalid zipcode or coordinates"})
+		return
+	}
+
+	switch msg.Action {
+	case "subscribe":
+		c.subscribe(key)
+	case "unsubscribe":
+		c.mu.Lock()
+		sub, ok := c.subs[key]
+		delete(c.subs, key)
+		c.mu.Unlock()
+		if ok {
+			sub.Close()
+		}
+		c.enqueue(ServerMessage{Type: "unsubscribed", Key: key})
+	default:
+		c.enqueue(ServerMessage{Type: "error", Key: key, Error: "unknown action"})
+	}
+}
+
+func (c *wsConn) subscribe(key string) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	if _, ok := c.subs[key]; ok {
+		c.mu.Unlock()
+		c.enqueue(ServerMessage{Type: "subscribed", Key: key})
+		return
+	}
+	if len(c.subs) >= c.handler.MaxSubscriptions {
+		c.mu.Unlock()
+		c.enqueue(ServerMessage{Type: "error", Key: key, Error: "subscription limit reached"})
+		return
+	}
+	sub := c.handler.Hub.Subscribe(key, 0)
+	c.subs[key] = sub
+	c.mu.Unlock()
+
+	c.enqueue(ServerMessage{Type: "subscribed", Key: key})
+	go c.forward(key, sub)
+}
+
+func (c *wsConn) forward(key string, sub *Subscription) {
+	for {
+		select {
+		case <-sub.Done():
+			return
+		case update := <-sub.C:
+			if update.Err != nil {
+				c.enqueue(ServerMessage{Type: "error", Key: key, Error: update.Err.Error()})
+				continue
+			}
+			c.enqueue(ServerMessage{
+				Type:                "temperature",
+				Key:                 key,
+				ObservedAt:          update.ID,
+				TemperatureResponse: weather.NewTemperatureResponse(utils.FromCelsius(update.Weather.Current.TempC)),
+			})
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package stream
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func dialWebSocket(t *testing.T, handler *WebSocketHandler) *websocket.Conn {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/temperature/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Failed to dial websocket: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) ServerMessage {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var msg ServerMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatalf("Failed to read message: %v", err)
+	}
+	return msg
+}
+
+// readUntil descarta mensagens até encontrar uma do tipo e chave esperados.
+func readUntil(t *testing.T, conn *websocket.Conn, msgType, key string) ServerMessage {
+	t.Helper()
+	for {
+		msg := readMessage(t, conn)
+		if msg.Type == msgType && (key == "" || msg.Key == key) {
+			return msg
+		}
+	}
+}
+
+func TestWebSocketHandler_SubscribeMultipleKeys(t *testing.T) {
+	upstream := newFakeUpstream(100, 25)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	conn := dialWebSocket(t, NewWebSocketHandler(hub))
+
+	conn.WriteJSON(ClientMessage{Action: "subscribe", CEP: "35630-016"})
+	lat, lon := -19.72, -45.25
+	conn.WriteJSON(ClientMessage{Action: "subscribe", Lat: &lat, Lon: &lon})
+
+	for _, key := range []string{"35630016", "-19.7200,-45.2500"} {
+		msg := readUntil(t, conn, "temperature", key)
+		if msg.TemperatureResponse == nil || msg.Temp_C != 25 || msg.Temp_F != 77 || msg.Temp_K != 298.15 {
+			t.Errorf("Unexpected temperature payload for %s: %+v", key, msg)
+		}
+		if msg.ObservedAt != 100 {
+			t.Errorf("Expected observed_at 100, got %d", msg.ObservedAt)
+		}
+	}
+
+	if hub.Pollers() != 2 {
+		t.Errorf("Expected 2 pollers, got %d", hub.Pollers())
+	}
+
+	conn.WriteJSON(ClientMessage{Action: "unsubscribe", CEP: "35630016"})
+	readUntil(t, conn, "unsubscribed", "35630016")
+	if hub.Pollers() != 1 {
+		t.Errorf("Expected 1 poller after unsubscribe, got %d", hub.Pollers())
+	}
+}
+
+func TestWebSocketHandler_SharesPollerAcrossConnections(t *testing.T) {
+	upstream := newFakeUpstream(100, 25)
+	hub := NewHub(upstream.Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	handler := NewWebSocketHandler(hub)
+	first := dialWebSocket(t, handler)
+	second := dialWebSocket(t, handler)
+
+	for _, conn := range []*websocket.Conn{first, second} {
+		conn.WriteJSON(ClientMessage{Action: "subscribe", CEP: "35630016"})
+		readUntil(t, conn, "temperature", "35630016")
+	}
+
+	if hub.Pollers() != 1 {
+		t.Errorf("Expected a single shared poller, got %d", hub.Pollers())
+	}
+
+	upstream.set(200, 30)
+	for _, conn := range []*websocket.Conn{first, second} {
+		for {
+			msg := readUntil(t, conn, "temperature", "35630016")
+			if msg.ObservedAt == 200 {
+				break
+			}
+		}
+	}
+}
+
+func TestWebSocketHandler_SubscriptionLimit(t *testing.T) {
+	hub := NewHub(newFakeUpstream(100, 25).Fetch, time.Second)
+	defer hub.Close()
+
+	handler := NewWebSocketHandler(hub)
+	handler.MaxSubscriptions = 1
+	conn := dialWebSocket(t, handler)
+
+	conn.WriteJSON(ClientMessage{Action: "subscribe", CEP: "35630016"})
+	readUntil(t, conn, "subscribed", "35630016")
+
+	conn.WriteJSON(ClientMessage{Action: "subscribe", CEP: "01001000"})
+	msg := readUntil(t, conn, "error", "01001000")
+	if msg.Error != "subscription limit reached" {
+		t.Errorf("Expected subscription limit error, got %s", msg.Error)
+	}
+}
+
+func TestWebSocketHandler_InvalidMessages(t *testing.T) {
+	hub := NewHub(newFakeUpstream(100, 25).Fetch, time.Second)
+	defer hub.Close()
+
+	conn := dialWebSocket(t, NewWebSocketHandler(hub))
+
+	conn.WriteMessage(websocket.TextMessage, []byte("not json"))
+	if msg := readMessage(t, conn); msg.Type != "error" || msg.Error != "invalid message" {
+		t.Errorf("Expected invalid message error, got %+v", msg)
+	}
+
+	conn.WriteJSON(ClientMessage{Action: "subscribe"})
+	if msg := readMessage(t, conn); msg.Type != "error" || msg.Error != "invalid zipcode or coordinates" {
+		t.Errorf("Expected invalid zipcode error, got %+v", msg)
+	}
+}
+
+func TestWebSocketHandler_TeardownOnDisconnect(t *testing.T) {
+	hub := NewHub(newFakeUpstream(100, 25).Fetch, 10*time.Millisecond)
+	defer hub.Close()
+
+	conn := dialWebSocket(t, NewWebSocketHandler(hub))
+	conn.WriteJSON(ClientMessage{Action: "subscribe", CEP: "35630016"})
+	readUntil(t, conn, "temperature", "35630016")
+	conn.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for hub.Pollers() != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if hub.Pollers() != 0 {
+		t.Errorf("Expected pollers to stop after disconnect, got %d", hub.Pollers())
+	}
+}
+
+func TestWSConn_CoalescesPendingUpdates(t *testing.T) {
+	c := &wsConn{pending: map[string]int{}, notify: make(chan struct{}, 1)}
+
+	c.enqueue(ServerMessage{Type: "subscribed", Key: "a"})
+	c.enqueue(ServerMessage{Type: "temperature", Key: "a", ObservedAt: 1})
+	c.enqueue(ServerMessage{Type: "temperature", Key: "b", ObservedAt: 1})
+	c.enqueue(ServerMessage{Type: "temperature", Key: "a", ObservedAt: 2})
+
+	if len(c.queue) != 3 {
+		t.Fatalf("Expected 3 queued messages, got %d", len(c.queue))
+	}
+	if c.queue[1].Key != "a" || c.queue[1].ObservedAt != 2 {
+		t.Errorf("Expected newest reading for a to replace the pending one, got %+v", c.queue[1])
+	}
+}
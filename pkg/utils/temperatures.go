@@ -1,5 +1,302 @@
 package utils
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Unit string
+
+const (
+	Celsius    Unit = "C"
+	Fahrenheit Unit = "F"
+	Kelvin     Unit = "K"
+	Rankine    Unit = "R"
+	Reaumur    Unit = "Re"
+)
+
+var Units = []Unit{Celsius, Fahrenheit, Kelvin, Rankine, Reaumur}
+
+var ErrUnknownUnit = errors.New("unknown temperature unit")
+
+func (u Unit) Valid() bool {
+	for _, known := range Units {
+		if u == known {
+			return true
+		}
+	}
+	return false
+}
+
+func (u Unit) Symbol() string {
+	switch u {
+	case Kelvin:
+		return "K"
+	case Reaumur:
+		return "°Ré"
+	case "":
+		return ""
+	default:
+		return "°" + string(u)
+	}
+}
+
+func (u Unit) String() string {
+	return string(u)
+}
+
+// ParseUnit accepts the unit code, its symbol or its English name,
+// case-insensitively.
+func ParseUnit(s string) (Unit, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "°")
+	s = strings.TrimPrefix(s, "º")
+	switch strings.ToLower(s) {
+	case "c", "celsius":
+		return Celsius, nil
+	case "f", "fahrenheit":
+		return Fahrenheit, nil
+	case "k", "kelvin":
+		return Kelvin, nil
+	case "r", "ra", "rankine":
+		return Rankine, nil
+	case "re", "ré", "reaumur", "réaumur":
+		return Reaumur, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownUnit, s)
+}
+
+type Temperature struct {
+	Value float64
+	Unit  Unit
+}
+
+func NewTemperature(value float64, unit Unit) Temperature {
+	return Temperature{Value: value, Unit: unit}
+}
+
+func FromCelsius(celsius float64) Temperature {
+	return Temperature{Value: celsius, Unit: Celsius}
+}
+
+func (t Temperature) celsius() float64 {
+	switch t.Unit {
+	case Fahrenheit:
+		return (t.Value - 32) * 5 / 9
+	case Kelvin:
+		return t.Value - 273.15
+	case Rankine:
+		return (t.Value - 491.67) * 5 / 9
+	case Reaumur:
+		return t.Value * 5 / 4
+	default:
+		return t.Value
+	}
+}
+
+func fromCelsius(celsius float64, unit Unit) float64 {
+	switch unit {
+	case Fahrenheit:
+		return (celsius * 9 / 5) + 32
+	case Kelvin:
+		return celsius + 273.15
+	case Rankine:
+		return (celsius + 273.15) * 9 / 5
+	case Reaumur:
+		return celsius * 4 / 5
+	default:
+		return celsius
+	}
+}
+
+// To converts the temperature to the given unit. Fahrenheit and Rankine
+// share a scale step, as do Celsius and Kelvin, so those pairs are
+// converted by offset only to avoid accumulating rounding error.
+func (t Temperature) To(unit Unit) Temperature {
+	if t.Unit == unit {
+		return t
+	}
+	switch {
+	case t.Unit == Fahrenheit && unit == Rankine:
+		return Temperature{Value: t.Value + 459.67, Unit: Rankine}
+	case t.Unit == Rankine && unit == Fahrenheit:
+		return Temperature{Value: t.Value - 459.67, Unit: Fahrenheit}
+	}
+	return Temperature{Value: fromCelsius(t.celsius(), unit), Unit: unit}
+}
+
+func (t Temperature) Celsius() float64    { return t.To(Celsius).Value }
+func (t Temperature) Fahrenheit() float64 { return t.To(Fahrenheit).Value }
+func (t Temperature) Kelvin() float64     { return t.To(Kelvin).Value }
+func (t Temperature) Rankine() float64    { return t.To(Rankine).Value }
+func (t Temperature) Reaumur() float64    { return t.To(Reaumur).Value }
+
+// Round returns the temperature rounded half away from zero to the given
+// number of decimal places. A negative precision leaves it untouched.
+func (t Temperature) Round(precision int) Temperature {
+	t.Value = RoundTo(t.Value, precision)
+	return t
+}
+
+func RoundTo(value float64, precision int) float64 {
+	if precision < 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+	pow := math.Pow(10, float64(precision))
+	return math.Round(value*pow) / pow
+}
+
+func (t Temperature) Equal(other Temperature, tolerance float64) bool {
+	return math.Abs(t.Value-other.To(t.Unit).Value) <= tolerance
+}
+
+func (t Temperature) BelowAbsoluteZero() bool {
+	return t.Kelvin() < 0
+}
+
+func (t Temperature) Format(precision int) string {
+	value := strconv.FormatFloat(t.Value, 'f', precision, 64)
+	if t.Unit == Kelvin {
+		return value + " K"
+	}
+	return value + t.Unit.Symbol()
+}
+
+func (t Temperature) String() string {
+	return t.Format(-1)
+}
+
+// ParseTemperature reads values such as "25.3°C", "25,3 ºC", "-4 F" or
+// "298.15K". A bare number is taken as Celsius.
+func ParseTemperature(s string) (Temperature, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Temperature{}, errors.New("empty temperature")
+	}
+
+	end := 0
+	for end < len(s) {
+		c := s[end]
+		if (c >= '0' && c <= '9') || c == '.' || c == ',' || c == '-' || c == '+' || c == 'e' || c == 'E' {
+			if (c == 'e' || c == 'E') && (end == 0 || !isDigit(s[end-1])) {
+				break
+			}
+			end++
+			continue
+		}
+		break
+	}
+
+	number := strings.Replace(s[:end], ",", ".", 1)
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return Temperature{}, fmt.Errorf("invalid temperature %q: %w", s, err)
+	}
+
+	rest := strings.TrimSpace(s[end:])
+	if rest == "" {
+		return FromCelsius(value), nil
+	}
+	unit, err := ParseUnit(rest)
+	if err != nil {
+		return Temperature{}, fmt.Errorf("invalid temperature %q: %w", s, err)
+	}
+	return Temperature{Value: value, Unit: unit}, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+type JSONFormat int
+
+const (
+	// JSONObject encodes as {"value":25.3,"unit":"C"}.
+	JSONObject JSONFormat = iota
+	// JSONString encodes as "25.3°C".
+	JSONString
+	// JSONNumber encodes the bare value, dropping the unit.
+	JSONNumber
+)
+
+type JSONOptions struct {
+	Format    JSONFormat
+	Precision int
+	Unit      Unit
+}
+
+// DefaultJSONOptions is used by Temperature.MarshalJSON.
+var DefaultJSONOptions = JSONOptions{Format: JSONObject, Precision: -1}
+
+type temperatureObject struct {
+	Value float64 `json:"value"`
+	Unit  Unit    `json:"unit"`
+}
+
+func (t Temperature) MarshalJSONWith(opts JSONOptions) ([]byte, error) {
+	if opts.Unit != "" {
+		t = t.To(opts.Unit)
+	}
+	t = t.Round(opts.Precision)
+	if t.Unit == "" {
+		t.Unit = Celsius
+	}
+
+	switch opts.Format {
+	case JSONString:
+		return json.Marshal(t.Format(opts.Precision))
+	case JSONNumber:
+		return json.Marshal(t.Value)
+	default:
+		return json.Marshal(temperatureObject{Value: t.Value, Unit: t.Unit})
+	}
+}
+
+func (t Temperature) MarshalJSON() ([]byte, error) {
+	return t.MarshalJSONWith(DefaultJSONOptions)
+}
+
+// UnmarshalJSON accepts any of the shapes produced by MarshalJSONWith.
+// Bare numbers are taken as Celsius.
+func (t *Temperature) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case float64:
+		*t = FromCelsius(v)
+	case string:
+		parsed, err := ParseTemperature(v)
+		if err != nil {
+			return err
+		}
+		*t = parsed
+	case map[string]interface{}:
+		var obj temperatureObject
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return err
+		}
+		unit := Celsius
+		if obj.Unit != "" {
+			parsed, err := ParseUnit(string(obj.Unit))
+			if err != nil {
+				return err
+			}
+			unit = parsed
+		}
+		*t = Temperature{Value: obj.Value, Unit: unit}
+	default:
+		return fmt.Errorf("cannot unmarshal %s into Temperature", string(data))
+	}
+	return nil
+}
+
 func ConvertFahrenheitToCelsius(fahrenheit float64) float64 {
 	return (fahrenheit - 32) * 5 / 9
 }
@@ -17,9 +314,10 @@ func ConvertKelvinToCelsius(kelvin float64) float64 {
 }
 
 func FormatTemperatures(celsius float64) map[string]float64 {
+	t := FromCelsius(celsius)
 	return map[string]float64{
-		"temp_C": celsius,
-		"temp_F": ConvertCelsiusToFahrenheit(celsius),
-		"temp_K": ConvertCelsiusToKelvin(celsius),
+		"temp_C": t.Celsius(),
+		"temp_F": t.Fahrenheit(),
+		"temp_K": t.Kelvin(),
 	}
-}
\ No newline at end of file
+}
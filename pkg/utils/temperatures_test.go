@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
+	"testing/quick"
 )
 
 func TestConvertFahrenheitToCelsius(t *testing.T) {
@@ -207,3 +210,163 @@ func TestFormatTemperatures(t *testing.T) {
 		})
 	}
 }
+
+func TestTemperatureTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Temperature
+		unit     Unit
+		expected float64
+	}{
+		{name: "0°C to 32°F", from: NewTemperature(0, Celsius), unit: Fahrenheit, expected: 32},
+		{name: "100°C to 373.15K", from: NewTemperature(100, Celsius), unit: Kelvin, expected: 373.15},
+		{name: "0°C to 491.67°R", from: NewTemperature(0, Celsius), unit: Rankine, expected: 491.67},
+		{name: "100°C to 80°Ré", from: NewTemperature(100, Celsius), unit: Reaumur, expected: 80},
+		{name: "212°F to 671.67°R", from: NewTemperature(212, Fahrenheit), unit: Rankine, expected: 671.67},
+		{name: "0K to -459.67°F", from: NewTemperature(0, Kelvin), unit: Fahrenheit, expected: -459.67},
+		{name: "80°Ré to 212°F", from: NewTemperature(80, Reaumur), unit: Fahrenheit, expected: 212},
+		{name: "0°R to 0K", from: NewTemperature(0, Rankine), unit: Kelvin, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.from.To(tt.unit)
+			if result.Unit != tt.unit {
+				t.Errorf("To(%s) unit = %s", tt.unit, result.Unit)
+			}
+			if math.Abs(result.Value-tt.expected) > 1e-9 {
+				t.Errorf("%v.To(%s) = %f, expected %f", tt.from, tt.unit, result.Value, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTemperatureRoundTrip(t *testing.T) {
+	// Propriedade: converter de A para B e voltar deve preservar o valor
+	for _, from := range Units {
+		for _, to := range Units {
+			from, to := from, to
+			t.Run(string(from)+"->"+string(to), func(t *testing.T) {
+				property := func(v float64) bool {
+					if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > 1e9 {
+						return true
+					}
+					original := NewTemperature(v, from)
+					back := original.To(to).To(from)
+					tolerance := 1e-9 * math.Max(1, math.Abs(v))
+					return original.Equal(back, tolerance)
+				}
+				if err := quick.Check(property, nil); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	}
+}
+
+func TestTemperatureRound(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision int
+		expected  float64
+	}{
+		{value: 25.345, precision: 2, expected: 25.35},
+		{value: -17.77777777777778, precision: 1, expected: -17.8},
+		{value: 298.15, precision: 0, expected: 298},
+		{value: 12.5, precision: 0, expected: 13},
+		{value: 12.3456, precision: -1, expected: 12.3456},
+	}
+
+	for _, tt := range tests {
+		result := FromCelsius(tt.value).Round(tt.precision)
+		if result.Value != tt.expected {
+			t.Errorf("Round(%f, %d) = %f, expected %f", tt.value, tt.precision, result.Value, tt.expected)
+		}
+	}
+}
+
+func TestParseTemperature(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Temperature
+		wantErr  bool
+	}{
+		{input: "25.3°C", expected: NewTemperature(25.3, Celsius)},
+		{input: "25,3 ºC", expected: NewTemperature(25.3, Celsius)},
+		{input: "-4 F", expected: NewTemperature(-4, Fahrenheit)},
+		{input: "298.15K", expected: NewTemperature(298.15, Kelvin)},
+		{input: "491.67°R", expected: NewTemperature(491.67, Rankine)},
+		{input: "20°Ré", expected: NewTemperature(20, Reaumur)},
+		{input: "18 celsius", expected: NewTemperature(18, Celsius)},
+		{input: "21.5", expected: NewTemperature(21.5, Celsius)},
+		{input: "", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "25°X", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTemperature(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTemperature(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && result != tt.expected {
+				t.Errorf("ParseTemperature(%q) = %+v, expected %+v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseTemperature_FormatRoundTrip(t *testing.T) {
+	property := func(v float64, unitIndex uint8) bool {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return true
+		}
+		original := NewTemperature(v, Units[int(unitIndex)%len(Units)])
+		parsed, err := ParseTemperature(original.String())
+		return err == nil && parsed == original
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTemperatureJSON(t *testing.T) {
+	temp := NewTemperature(25.345, Celsius)
+
+	tests := []struct {
+		name     string
+		opts     JSONOptions
+		expected string
+	}{
+		{name: "objeto", opts: DefaultJSONOptions, expected: `{"value":25.345,"unit":"C"}`},
+		{name: "string", opts: JSONOptions{Format: JSONString, Precision: 1}, expected: `"25.3°C"`},
+		{name: "número", opts: JSONOptions{Format: JSONNumber, Precision: 2}, expected: `25.35`},
+		{name: "kelvin", opts: JSONOptions{Format: JSONString, Precision: 2, Unit: Kelvin}, expected: `"298.50 K"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := temp.MarshalJSONWith(tt.opts)
+			if err != nil {
+				t.Fatalf("MarshalJSONWith() error = %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("MarshalJSONWith() = %s, expected %s", data, tt.expected)
+			}
+
+			var decoded Temperature
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", data, err)
+			}
+		})
+	}
+
+	var decoded Temperature
+	if err := json.Unmarshal([]byte(`{"value":77,"unit":"°F"}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal error = %v", err)
+	}
+	if decoded != NewTemperature(77, Fahrenheit) {
+		t.Errorf("Unmarshal = %+v, expected 77°F", decoded)
+	}
+}
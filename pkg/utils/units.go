@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type SpeedUnit string
+
+const (
+	MetersPerSecond   SpeedUnit = "m/s"
+	KilometersPerHour SpeedUnit = "km/h"
+	MilesPerHour      SpeedUnit = "mph"
+	Knots             SpeedUnit = "kn"
+	Beaufort          SpeedUnit = "Bft"
+)
+
+var speedFactors = map[SpeedUnit]float64{
+	MetersPerSecond:   1,
+	KilometersPerHour: 1 / 3.6,
+	MilesPerHour:      0.44704,
+	Knots:             1852.0 / 3600.0,
+}
+
+type Speed struct {
+	Value float64
+	Unit  SpeedUnit
+}
+
+func NewSpeed(value float64, unit SpeedUnit) Speed {
+	return Speed{Value: value, Unit: unit}
+}
+
+// metersPerSecond treats Beaufort as the continuous empirical scale
+// v = 0.836·B^(3/2) so it can be converted in both directions.
+func (s Speed) metersPerSecond() float64 {
+	if s.Unit == Beaufort {
+		return 0.836 * math.Pow(math.Max(s.Value, 0), 1.5)
+	}
+	return s.Value * speedFactors[s.Unit]
+}
+
+func (s Speed) To(unit SpeedUnit) Speed {
+	if s.Unit == unit {
+		return s
+	}
+	ms := s.metersPerSecond()
+	if unit == Beaufort {
+		return Speed{Value: math.Pow(math.Abs(ms)/0.836, 2.0/3.0), Unit: Beaufort}
+	}
+	return Speed{Value: ms / speedFactors[unit], Unit: unit}
+}
+
+// BeaufortForce returns the Beaufort force number (0-12) using the WMO
+// upper limits in m/s.
+func (s Speed) BeaufortForce() int {
+	limits := []float64{0.5, 1.5, 3.3, 5.5, 7.9, 10.7, 13.8, 17.1, 20.7, 24.4, 28.4, 32.6}
+	ms := math.Abs(s.metersPerSecond())
+	for force, limit := range limits {
+		if ms < limit {
+			return force
+		}
+	}
+	return 12
+}
+
+func (s Speed) String() string {
+	return formatQuantity(s.Value, string(s.Unit))
+}
+
+type PressureUnit string
+
+const (
+	Hectopascal        PressureUnit = "hPa"
+	Kilopascal         PressureUnit = "kPa"
+	InchesOfMercury    PressureUnit = "inHg"
+	MillimetersMercury PressureUnit = "mmHg"
+)
+
+var pressureFactors = map[PressureUnit]float64{
+	Hectopascal:        100,
+	Kilopascal:         1000,
+	InchesOfMercury:    3386.389,
+	MillimetersMercury: 133.322387415,
+}
+
+type Pressure struct {
+	Value float64
+	Unit  PressureUnit
+}
+
+func NewPressure(value float64, unit PressureUnit) Pressure {
+	return Pressure{Value: value, Unit: unit}
+}
+
+func (p Pressure) To(unit PressureUnit) Pressure {
+	if p.Unit == unit {
+		return p
+	}
+	return Pressure{Value: p.Value * pressureFactors[p.Unit] / pressureFactors[unit], Unit: unit}
+}
+
+func (p Pressure) String() string {
+	return formatQuantity(p.Value, string(p.Unit))
+}
+
+type LengthUnit string
+
+const (
+	Millimeters LengthUnit = "mm"
+	Centimeters LengthUnit = "cm"
+	Meters      LengthUnit = "m"
+	Kilometers  LengthUnit = "km"
+	Inches      LengthUnit = "in"
+	Feet        LengthUnit = "ft"
+	Miles       LengthUnit = "mi"
+)
+
+var lengthFactors = map[LengthUnit]float64{
+	Millimeters: 0.001,
+	Centimeters: 0.01,
+	Meters:      1,
+	Kilometers:  1000,
+	Inches:      0.0254,
+	Feet:        0.3048,
+	Miles:       1609.344,
+}
+
+// Length is also used for precipitation depth.
+type Length struct {
+	Value float64
+	Unit  LengthUnit
+}
+
+func NewLength(value float64, unit LengthUnit) Length {
+	return Length{Value: value, Unit: unit}
+}
+
+func (l Length) To(unit LengthUnit) Length {
+	if l.Unit == unit {
+		return l
+	}
+	return Length{Value: l.Value * lengthFactors[l.Unit] / lengthFactors[unit], Unit: unit}
+}
+
+func (l Length) String() string {
+	return formatQuantity(l.Value, string(l.Unit))
+}
+
+func formatQuantity(value float64, unit string) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + " " + unit
+}
+
+type UnitSystem string
+
+const (
+	Metric   UnitSystem = "metric"
+	Imperial UnitSystem = "imperial"
+	SI       UnitSystem = "si"
+)
+
+// SystemUnits lists the unit used for each quantity in a UnitSystem.
+type SystemUnits struct {
+	Temperature   Unit
+	Speed         SpeedUnit
+	Pressure      PressureUnit
+	Precipitation LengthUnit
+	Visibility    LengthUnit
+}
+
+var unitSystems = map[UnitSystem]SystemUnits{
+	Metric:   {Temperature: Celsius, Speed: KilometersPerHour, Pressure: Hectopascal, Precipitation: Millimeters, Visibility: Kilometers},
+	Imperial: {Temperature: Fahrenheit, Speed: MilesPerHour, Pressure: InchesOfMercury, Precipitation: Inches, Visibility: Miles},
+	SI:       {Temperature: Kelvin, Speed: MetersPerSecond, Pressure: Kilopascal, Precipitation: Millimeters, Visibility: Meters},
+}
+
+// ParseUnitSystem defaults to Metric when s is empty.
+func ParseUnitSystem(s string) (UnitSystem, error) {
+	if s == "" {
+		return Metric, nil
+	}
+	system := UnitSystem(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := unitSystems[system]; !ok {
+		return "", fmt.Errorf("unknown unit system %q", s)
+	}
+	return system, nil
+}
+
+func (u UnitSystem) Units() SystemUnits {
+	if units, ok := unitSystems[u]; ok {
+		return units
+	}
+	return unitSystems[Metric]
+}
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"math"
+	"testing"
+	"testing/quick"
+)
+
+func TestSpeedTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Speed
+		unit     SpeedUnit
+		expected float64
+	}{
+		{name: "36 km/h to 10 m/s", from: NewSpeed(36, KilometersPerHour), unit: MetersPerSecond, expected: 10},
+		{name: "10 mph to 16.09 km/h", from: NewSpeed(10, MilesPerHour), unit: KilometersPerHour, expected: 16.09344},
+		{name: "1 kn to 1.852 km/h", from: NewSpeed(1, Knots), unit: KilometersPerHour, expected: 1.852},
+		{name: "Beaufort 4 to m/s", from: NewSpeed(4, Beaufort), unit: MetersPerSecond, expected: 6.688},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.from.To(tt.unit)
+			if math.Abs(result.Value-tt.expected) > 1e-9 {
+				t.Errorf("%v.To(%s) = %f, expected %f", tt.from, tt.unit, result.Value, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpeedBeaufortForce(t *testing.T) {
+	tests := []struct {
+		speed    Speed
+		expected int
+	}{
+		{speed: NewSpeed(0, KilometersPerHour), expected: 0},
+		{speed: NewSpeed(10, KilometersPerHour), expected: 2},
+		{speed: NewSpeed(30, KilometersPerHour), expected: 5},
+		{speed: NewSpeed(50, Knots), expected: 10},
+		{speed: NewSpeed(150, KilometersPerHour), expected: 12},
+	}
+
+	for _, tt := range tests {
+		if result := tt.speed.BeaufortForce(); result != tt.expected {
+			t.Errorf("%v.BeaufortForce() = %d, expected %d", tt.speed, result, tt.expected)
+		}
+	}
+}
+
+func TestPressureTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Pressure
+		unit     PressureUnit
+		expected float64
+	}{
+		{name: "1013.25 hPa to inHg", from: NewPressure(1013.25, Hectopascal), unit: InchesOfMercury, expected: 29.92},
+		{name: "1013.25 hPa to mmHg", from: NewPressure(1013.25, Hectopascal), unit: MillimetersMercury, expected: 760},
+		{name: "1013.25 hPa to kPa", from: NewPressure(1013.25, Hectopascal), unit: Kilopascal, expected: 101.325},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.from.To(tt.unit)
+			if math.Abs(result.Value-tt.expected) > 0.01 {
+				t.Errorf("%v.To(%s) = %f, expected %f", tt.from, tt.unit, result.Value, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLengthTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Length
+		unit     LengthUnit
+		expected float64
+	}{
+		{name: "25.4 mm to 1 in", from: NewLength(25.4, Millimeters), unit: Inches, expected: 1},
+		{name: "10 km to 6.21 mi", from: NewLength(10, Kilometers), unit: Miles, expected: 6.2137},
+		{name: "1 mi to 5280 ft", from: NewLength(1, Miles), unit: Feet, expected: 5280},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.from.To(tt.unit)
+			if math.Abs(result.Value-tt.expected) > 1e-4 {
+				t.Errorf("%v.To(%s) = %f, expected %f", tt.from, tt.unit, result.Value, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnitsRoundTrip(t *testing.T) {
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(a))
+	}
+	valid := func(v float64) bool {
+		return !math.IsNaN(v) && !math.IsInf(v, 0) && math.Abs(v) < 1e9
+	}
+
+	speedUnits := []SpeedUnit{MetersPerSecond, KilometersPerHour, MilesPerHour, Knots, Beaufort}
+	speed := func(v float64, a, b uint8) bool {
+		v = math.Abs(v)
+		if !valid(v) {
+			return true
+		}
+		from, to := speedUnits[int(a)%len(speedUnits)], speedUnits[int(b)%len(speedUnits)]
+		return near(v, NewSpeed(v, from).To(to).To(from).Value)
+	}
+
+	pressureUnits := []PressureUnit{Hectopascal, Kilopascal, InchesOfMercury, MillimetersMercury}
+	pressure := func(v float64, a, b uint8) bool {
+		if !valid(v) {
+			return true
+		}
+		from, to := pressureUnits[int(a)%len(pressureUnits)], pressureUnits[int(b)%len(pressureUnits)]
+		return near(v, NewPressure(v, from).To(to).To(from).Value)
+	}
+
+	lengthUnits := []LengthUnit{Millimeters, Centimeters, Meters, Kilometers, Inches, Feet, Miles}
+	length := func(v float64, a, b uint8) bool {
+		if !valid(v) {
+			return true
+		}
+		from, to := lengthUnits[int(a)%len(lengthUnits)], lengthUnits[int(b)%len(lengthUnits)]
+		return near(v, NewLength(v, from).To(to).To(from).Value)
+	}
+
+	for name, property := range map[string]interface{}{"speed": speed, "pressure": pressure, "length": length} {
+		if err := quick.Check(property, nil); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestParseUnitSystem(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected UnitSystem
+		wantErr  bool
+	}{
+		{input: "", expected: Metric},
+		{input: "metric", expected: Metric},
+		{input: "Imperial", expected: Imperial},
+		{input: "si", expected: SI},
+		{input: "nautical", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		result, err := ParseUnitSystem(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseUnitSystem(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if result != tt.expected {
+			t.Errorf("ParseUnitSystem(%q) = %s, expected %s", tt.input, result, tt.expected)
+		}
+	}
+
+	if units := Imperial.Units(); units.Temperature != Fahrenheit || units.Speed != MilesPerHour {
+		t.Errorf("Unexpected imperial units: %+v", units)
+	}
+}
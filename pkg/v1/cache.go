@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"sync"
+	"temperature_server/pkg/cep"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+// reading is a cached lookup. It is reused until WeatherAPI is expected to
+// publish the next one (weather.ObservationMaxAge).
+type reading struct {
+	address *viacep.CEPResponse
+	weather *weather.WeatherResponse
+	expires time.Time
+}
+
+type readingCache struct {
+	mu      sync.Mutex
+	entries map[string]reading
+}
+
+func newReadingCache() *readingCache {
+	return &readingCache{entries: map[string]reading{}}
+}
+
+// cacheKey lets the layouts of a CEP share an entry.
+func cacheKey(code string) string {
+	if parsed, err := cep.Parse(code); err == nil {
+		return parsed.Digits()
+	}
+	return code
+}
+
+func (c *readingCache) get(cep string, now time.Time) (reading, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[cacheKey(cep)]
+	if !ok || !now.Before(entry.expires) {
+		return reading{}, false
+	}
+	return entry, true
+}
+
+// put stores entry and drops expired ones, so only CEPs requested within
+// the last refresh interval are kept.
+func (c *readingCache) put(cep string, entry reading, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, existing := range c.entries {
+		if !now.Before(existing.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[cacheKey(cep)] = entry
+}
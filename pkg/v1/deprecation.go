@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// LegacyDeprecatedAt is when the unversioned routes were superseded by /v1.
+var LegacyDeprecatedAt = time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+// Deprecated marks the responses of a legacy route with Deprecation
+// (RFC 9745), a Link to its successor and, when sunset is set, the date it
+// will be removed (Sunset, RFC 8594). The legacy response is unchanged.
+func Deprecated(next http.Handler, successor string, sunset time.Time) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		h.Set("Deprecation", "@"+strconv.FormatInt(LegacyDeprecatedAt.Unix(), 10))
+		h.Add("Link", "<"+successor+`>; rel="successor-version"`)
+		if !sunset.IsZero() {
+			h.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"temperature_server/internal/upstreamtest"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+func TestDeprecated(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("legacy"))
+	})
+	sunset := time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	rec := httptest.NewRecorder()
+	Deprecated(next, "/v1/temperature", sunset).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/temperature", nil))
+
+	if got := rec.Header().Get("Deprecation"); got != "@1792281600" {
+		t.Errorf("unexpected Deprecation: %q", got)
+	}
+	if got := rec.Header().Get("Link"); got != `</v1/temperature>; rel="successor-version"` {
+		t.Errorf("unexpected Link: %q", got)
+	}
+	if got := rec.Header().Get("Sunset"); got != "Thu, 01 Apr 2027 00:00:00 GMT" {
+		t.Errorf("unexpected Sunset: %q", got)
+	}
+	if rec.Body.String() != "legacy" {
+		t.Errorf("expected the legacy body, got %q", rec.Body.String())
+	}
+
+	// Sem data de remoção o Sunset não é enviado
+	rec = httptest.NewRecorder()
+	Deprecated(next, "/v1/temperature", time.Time{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/temperature", nil))
+	if _, ok := rec.Header()["Sunset"]; ok {
+		t.Error("expected no Sunset header")
+	}
+}
+
+func TestContracts(t *testing.T) {
+	upstreamtest.New(t, &viacep.BaseURL, &weather.BaseURL)
+	mux := http.NewServeMux()
+	mux.Handle("/temperature", Deprecated(http.HandlerFunc(weather.TemperatureHandler), "/v1/temperature", time.Time{}))
+	NewHandler().Register(mux)
+
+	// O contrato legado continua plano, apenas com os cabeçalhos de descontinuação
+	legacy := get(mux, "/temperature?cep=35630-016")
+	if legacy.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", legacy.Code, legacy.Body.String())
+	}
+	if got := strings.TrimSpace(legacy.Body.String()); got != `{"temp_C":25,"temp_F":77,"temp_K":298.15}` {
+		t.Errorf("legacy contract changed: %s", got)
+	}
+	if legacy.Header().Get("Deprecation") == "" || legacy.Header().Get("Link") == "" {
+		t.Error("expected deprecation headers on /temperature")
+	}
+
+	current := get(mux, "/v1/temperature?cep=35630-016")
+	if current.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", current.Code, current.Body.String())
+	}
+	if current.Header().Get("Deprecation") != "" {
+		t.Error("expected no Deprecation header on /v1/temperature")
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(current.Body.Bytes(), &envelope); err != nil {
+		t.Fatalf("failed to decode envelope: %v", err)
+	}
+	for _, key := range []string{"data", "location", "provider", "observed_at", "cache"} {
+		if _, ok := envelope[key]; !ok {
+			t.Errorf("expected %q in the envelope", key)
+		}
+	}
+	if len(envelope) != 5 {
+		t.Errorf("expected 5 envelope fields, got %d", len(envelope))
+	}
+	if got := string(envelope["data"]); got != `{"temp_C":25,"temp_F":77,"temp_K":298.15}` {
+		t.Errorf("unexpected data: %s", got)
+	}
+}
@@ -0,0 +1,50 @@
+// Package v1 serves the versioned REST API under /v1, where every payload
+// is wrapped in a Response envelope so fields can be added to the data or
+// its metadata without breaking clients.
+package v1
+
+import (
+	"fmt"
+	"temperature_server/pkg/render"
+	"time"
+)
+
+// Provider identifies where readings come from.
+const Provider = "weatherapi"
+
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// Location is the address the requested CEP resolved to in ViaCEP.
+type Location struct {
+	CEP  string `json:"cep"`
+	City string `json:"city"`
+	UF   string `json:"uf"`
+}
+
+// CacheStatus tells whether the reading was served from the server's
+// cache and until when it is reused.
+type CacheStatus struct {
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type Response struct {
+	Data       interface{} `json:"data"`
+	Location   Location    `json:"location"`
+	Provider   string      `json:"provider"`
+	ObservedAt time.Time   `json:"observed_at"`
+	Cache      CacheStatus `json:"cache"`
+}
+
+func (r Response) Text() string {
+	data := fmt.Sprint(r.Data)
+	if texter, ok := r.Data.(render.Texter); ok {
+		data = texter.Text()
+	}
+	return fmt.Sprintf("%s\nlocation: %s, %s/%s\nobserved at: %s (%s, cache %s)",
+		data, r.Location.CEP, r.Location.City, r.Location.UF,
+		r.ObservedAt.Format(time.RFC3339), r.Provider, r.Cache.Status)
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/utils"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+type Handler struct {
+	// Fetch is weather.GetReadingByCEP outside of tests.
+	Fetch func(cep string) (*viacep.CEPResponse, *weather.WeatherResponse, error)
+	Now   func() time.Time
+
+	cache *readingCache
+}
+
+func NewHandler() *Handler {
+	return &Handler{Fetch: weather.GetReadingByCEP, Now: time.Now, cache: newReadingCache()}
+}
+
+// Register mounts the v1 API on mux:
+//
+//	GET /v1/temperature
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/temperature", h.temperature)
+}
+
+// lookup returns the reading for cep, from the cache while WeatherAPI has
+// not published a newer one.
+func (h *Handler) lookup(cep string, now time.Time) (reading, CacheStatus, error) {
+	if cached, ok := h.cache.get(cep, now); ok {
+		return cached, CacheStatus{Status: CacheHit, ExpiresAt: cached.expires.UTC()}, nil
+	}
+
+	address, weatherData, err := h.Fetch(cep)
+	if err != nil {
+		return reading{}, CacheStatus{}, err
+	}
+	observed := time.Unix(weatherData.Current.LastUpdatedEpoch, 0)
+	fresh := reading{
+		address: address,
+		weather: weatherData,
+		expires: now.Add(weather.ObservationMaxAge(observed, now)),
+	}
+	h.cache.put(cep, fresh, now)
+	return fresh, CacheStatus{Status: CacheMiss, ExpiresAt: fresh.expires.UTC()}, nil
+}
+
+func (h *Handler) temperature(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept")
+	w.Header().Add("Vary", "Accept-Language")
+	format, err := render.Negotiate(r)
+	if err != nil {
+		weather.WriteError(w, r, render.JSON, http.StatusNotAcceptable, "not acceptable")
+		return
+	}
+
+	cep := r.URL.Query().Get("cep")
+	if cep == "" {
+		weather.WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	now := h.Now()
+	found, cache, err := h.lookup(cep, now)
+	if err != nil {
+		weather.WriteServiceError(w, r, format, err)
+		return
+	}
+
+	observed := time.Unix(found.weather.Current.LastUpdatedEpoch, 0).UTC()
+	response := Response{
+		Data: weather.NewTemperatureResponse(utils.FromCelsius(found.weather.Current.TempC)),
+		Location: Location{
+			CEP:  found.address.CEP,
+			City: found.address.Localidade,
+			UF:   found.address.UF,
+		},
+		Provider:   Provider,
+		ObservedAt: observed,
+		Cache:      cache,
+	}
+
+	w.Header().Set("X-Cache", strings.ToUpper(cache.Status))
+	render.WriteConditional(w, r, format, response, render.Validators{
+		LastModified: observed,
+		MaxAge:       cache.ExpiresAt.Sub(now).Truncate(time.Second),
+	})
+}
@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"temperature_server/pkg/viacep"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+// observedAt é o horário da leitura usada nos testes.
+var observedAt = time.Date(2025, time.July, 10, 0, 15, 0, 0, time.UTC)
+
+type fakeUpstream struct {
+	calls int
+	tempC float64
+	err   error
+}
+
+func (f *fakeUpstream) fetch(cep string) (*viacep.CEPResponse, *weather.WeatherResponse, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	data := &weather.WeatherResponse{}
+	data.Current.TempC = f.tempC
+	data.Current.LastUpdatedEpoch = observedAt.Unix()
+	return &viacep.CEPResponse{CEP: "35630-016", Localidade: "Bom Despacho", UF: "MG"}, data, nil
+}
+
+func newTestHandler(upstream *fakeUpstream, now *time.Time) *http.ServeMux {
+	h := NewHandler()
+	h.Fetch = upstream.fetch
+	h.Now = func() time.Time { return *now }
+	mux := http.NewServeMux()
+	h.Register(mux)
+	return mux
+}
+
+func get(mux *http.ServeMux, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestTemperatureEnvelope(t *testing.T) {
+	upstream := &fakeUpstream{tempC: 25}
+	now := observedAt.Add(5 * time.Minute)
+	mux := newTestHandler(upstream, &now)
+
+	rec := get(mux, "/v1/temperature?cep=35630-016")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	// O contrato da v1 fica fixado campo a campo
+	expected := `{"data":{"temp_C":25,"temp_F":77,"temp_K":298.15},` +
+		`"location":{"cep":"35630-016","city":"Bom Despacho","uf":"MG"},` +
+		`"provider":"weatherapi","observed_at":"2025-07-10T00:15:00Z",` +
+		`"cache":{"status":"miss","expires_at":"2025-07-10T00:30:00Z"}}`
+	if got := strings.TrimSpace(rec.Body.String()); got != expected {
+		t.Errorf("unexpected body:\n got %s\nwant %s", got, expected)
+	}
+	if rec.Header().Get("X-Cache") != "MISS" {
+		t.Errorf("expected X-Cache MISS, got %q", rec.Header().Get("X-Cache"))
+	}
+	if rec.Header().Get("Cache-Control") != "public, max-age=600" {
+		t.Errorf("unexpected Cache-Control: %q", rec.Header().Get("Cache-Control"))
+	}
+	if rec.Header().Get("Last-Modified") != observedAt.Format(http.TimeFormat) {
+		t.Errorf("unexpected Last-Modified: %q", rec.Header().Get("Last-Modified"))
+	}
+}
+
+func TestTemperatureCache(t *testing.T) {
+	upstream := &fakeUpstream{tempC: 25}
+	now := observedAt.Add(5 * time.Minute)
+	mux := newTestHandler(upstream, &now)
+
+	get(mux, "/v1/temperature?cep=35630-016")
+
+	// Mesmo CEP em outro formato, antes da próxima atualização do provedor
+	now = now.Add(4 * time.Minute)
+	rec := get(mux, "/v1/temperature?cep=35630016")
+	var response struct {
+		Cache CacheStatus `json:"cache"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if upstream.calls != 1 {
+		t.Errorf("expected 1 upstream call, got %d", upstream.calls)
+	}
+	if response.Cache.Status != CacheHit || rec.Header().Get("X-Cache") != "HIT" {
+		t.Errorf("expected a cache hit, got %q / %q", response.Cache.Status, rec.Header().Get("X-Cache"))
+	}
+	if rec.Header().Get("Cache-Control") != "public, max-age=360" {
+		t.Errorf("unexpected Cache-Control: %q", rec.Header().Get("Cache-Control"))
+	}
+
+	// Após a expiração a leitura é buscada de novo
+	now = now.Add(10 * time.Minute)
+	rec = get(mux, "/v1/temperature?cep=35630-016")
+	if upstream.calls != 2 || rec.Header().Get("X-Cache") != "MISS" {
+		t.Errorf("expected a new upstream call, got %d calls and X-Cache %q", upstream.calls, rec.Header().Get("X-Cache"))
+	}
+}
+
+func TestTemperatureErrors(t *testing.T) {
+	now := observedAt
+	tests := []struct {
+		name     string
+		target   string
+		err      error
+		expected int
+	}{
+		{"missing cep", "/v1/temperature", nil, http.StatusBadRequest},
+		{"upstream failure", "/v1/temperature?cep=35630016", errors.New("can not find zipcode"), http.StatusInternalServerError},
+		{"not acceptable", "/v1/temperature?cep=35630016&format=pdf", nil, http.StatusNotAcceptable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := newTestHandler(&fakeUpstream{err: tt.err}, &now)
+			rec := get(mux, tt.target)
+			if rec.Code != tt.expected {
+				t.Fatalf("expected status %d, got %d", tt.expected, rec.Code)
+			}
+			var response weather.ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil || response.Error == "" {
+				t.Errorf("expected an ErrorResponse, got %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTemperatureText(t *testing.T) {
+	now := observedAt
+	mux := newTestHandler(&fakeUpstream{tempC: 25}, &now)
+
+	rec := get(mux, "/v1/temperature?cep=35630-016&format=text")
+	expected := "temperature: 25 °C / 77 °F / 298.15 K\n" +
+		"location: 35630-016, Bom Despacho/MG\n" +
+		"observed at: 2025-07-10T00:15:00Z (weatherapi, cache miss)\n"
+	if rec.Body.String() != expected {
+		t.Errorf("unexpected text:\n got %q\nwant %q", rec.Body.String(), expected)
+	}
+}
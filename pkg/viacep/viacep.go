@@ -3,13 +3,14 @@ package viacep
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	"temperature_server/pkg/cep"
 )
 
+var BaseURL = "https://viacep.com.br/ws"
+
 type CEPResponse struct {
 	CEP         string `json:"cep"`
 	Logradouro  string `json:"logradouro"`
@@ -26,17 +27,17 @@ type CEPResponse struct {
 	SIAFI       string `json:"siafi"`
 }
 
-func FetchCEPData(cep string) (*CEPResponse, error) {
-	cep = strings.ReplaceAll(cep, "-", "")
-	cep = strings.ReplaceAll(cep, ".", "")
-	cep = strings.ReplaceAll(cep, " ", "")
-
-	if len(cep) != 8 {
-		return nil, errors.New("invalid zipcode")
+// FetchCEPData looks code up on ViaCEP. CEPs cep.Parse rejects never reach
+// it, and an answer for a UF other than the one the CEP's range belongs to
+// is an error.
+func FetchCEPData(code string) (*CEPResponse, error) {
+	parsed, err := cep.Parse(code)
+	if err != nil {
+		return nil, err
 	}
 
 	var result CEPResponse
-	url := fmt.Sprintf("https://viacep.com.br/ws/%s/json", cep)
+	url := fmt.Sprintf("%s/%s/json", BaseURL, parsed.Digits())
 	fmt.Println(url)
 	req, err := http.NewRequestWithContext(context.Background(), "GET", url, nil)
 	if err != nil {
@@ -56,5 +57,14 @@ func FetchCEPData(cep string) (*CEPResponse, error) {
 	}
 	err = json.Unmarshal(body, &result)
 	fmt.Println(result)
-	return &result, err
+	if err != nil {
+		return &result, err
+	}
+	// ViaCEP answers unknown CEPs with {"erro": "true"} and no UF
+	if result.UF != "" {
+		if err := parsed.CheckUF(result.UF); err != nil {
+			return &result, err
+		}
+	}
+	return &result, nil
 }
@@ -2,8 +2,10 @@ package viacep
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"temperature_server/pkg/cep"
 	"testing"
 )
 
@@ -173,3 +175,45 @@ func TestCEPResponse_JSONUnmarshal(t *testing.T) {
 		t.Errorf("Expected Estado Minas Gerais, got %s", response.Estado)
 	}
 }
+
+func TestFetchCEPData_RejectsImpossibleCEP(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { calls++ }))
+	defer server.Close()
+	original := BaseURL
+	BaseURL = server.URL + "/ws"
+	t.Cleanup(func() { BaseURL = original })
+
+	for _, input := range []string{"00000000", "3563001a", "３５６３００１６"} {
+		if _, err := FetchCEPData(input); !errors.Is(err, cep.ErrInvalid) {
+			t.Errorf("%q: expected invalid zipcode, got %v", input, err)
+		}
+	}
+	// CEPs impossíveis não chegam ao ViaCEP
+	if calls != 0 {
+		t.Errorf("expected no calls to ViaCEP, got %d", calls)
+	}
+}
+
+func TestFetchCEPData_UFMismatch(t *testing.T) {
+	uf := "SP"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ws/35630016/json" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		json.NewEncoder(w).Encode(CEPResponse{CEP: "35630-016", Localidade: "Abaeté", UF: uf})
+	}))
+	defer server.Close()
+	original := BaseURL
+	BaseURL = server.URL + "/ws"
+	t.Cleanup(func() { BaseURL = original })
+
+	if _, err := FetchCEPData("35.630-016"); !errors.Is(err, cep.ErrUFMismatch) {
+		t.Errorf("expected UF mismatch, got %v", err)
+	}
+
+	uf = "MG"
+	if result, err := FetchCEPData("35.630-016"); err != nil || result.Localidade != "Abaeté" {
+		t.Errorf("unexpected %+v, %v", result, err)
+	}
+}
@@ -0,0 +1,14 @@
+package watchlist
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// StatusHandler serves the scheduler's last-run status as JSON.
+func StatusHandler(s *Scheduler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.Status())
+	}
+}
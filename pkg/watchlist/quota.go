@@ -0,0 +1,68 @@
+package watchlist
+
+import (
+	"sync"
+	"time"
+)
+
+// RequestsPerLookup is how many WeatherAPI calls one CEP lookup costs
+// (search.json and current.json).
+const RequestsPerLookup = 2
+
+// Quota is a fixed-window budget of WeatherAPI requests. A zero Limit means
+// unlimited.
+type Quota struct {
+	Limit  int
+	Period time.Duration
+
+	mu          sync.Mutex
+	used        int
+	windowStart time.Time
+}
+
+func NewQuota(limit int, period time.Duration) *Quota {
+	if period <= 0 {
+		period = 24 * time.Hour
+	}
+	return &Quota{Limit: limit, Period: period}
+}
+
+func (q *Quota) roll(now time.Time) {
+	if q.windowStart.IsZero() || now.Sub(q.windowStart) >= q.Period {
+		q.windowStart = now.Truncate(q.Period)
+		q.used = 0
+	}
+}
+
+// Take reserves n requests, returning false when the window is exhausted.
+func (q *Quota) Take(n int, now time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.roll(now)
+	if q.Limit > 0 && q.used+n > q.Limit {
+		return false
+	}
+	q.used += n
+	return true
+}
+
+type QuotaStatus struct {
+	Limit       int       `json:"limit"`
+	Used        int       `json:"used"`
+	WindowStart time.Time `json:"window_start"`
+	ResetsAt    time.Time `json:"resets_at"`
+}
+
+func (q *Quota) Status(now time.Time) QuotaStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.roll(now)
+	return QuotaStatus{
+		Limit:       q.Limit,
+		Used:        q.used,
+		WindowStart: q.windowStart,
+		ResetsAt:    q.windowStart.Add(q.Period),
+	}
+}
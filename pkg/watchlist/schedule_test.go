@@ -0,0 +1,63 @@
+package watchlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleNext(t *testing.T) {
+	base := time.Date(2025, 7, 9, 10, 7, 30, 0, time.UTC) // quarta-feira
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"@every 90s", base.Add(90 * time.Second)},
+		{"@hourly", time.Date(2025, 7, 9, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 7, 9, 10, 15, 0, 0, time.UTC)},
+		{"0,30 8-9 * * *", time.Date(2025, 7, 10, 8, 0, 0, 0, time.UTC)},
+		{"0 6 * * 1-5", time.Date(2025, 7, 10, 6, 0, 0, 0, time.UTC)},
+		{"0 6 * * 7", time.Date(2025, 7, 13, 6, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.spec)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.spec, err)
+			continue
+		}
+		if next := schedule.Next(base); !next.Equal(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.spec, test.expected, next)
+		}
+	}
+}
+
+func TestParseScheduleDayOfMonthOrWeek(t *testing.T) {
+	// Com dia do mês e dia da semana restritos, basta um deles coincidir.
+	schedule, _ := ParseSchedule("0 0 20 * 0")
+	next := schedule.Next(time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC))
+	if expected := time.Date(2025, 7, 13, 0, 0, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, next)
+	}
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, spec := range []string{"", "@every", "@every 0s", "@yearly", "* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("%q: expected error", spec)
+		}
+	}
+}
+
+func TestImpossibleScheduleNeverFires(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 31 2 *")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if next := schedule.Next(time.Now()); !next.IsZero() {
+		t.Errorf("Expected zero time, got %v", next)
+	}
+}
@@ -0,0 +1,234 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"sort"
+	"sync"
+	"temperature_server/pkg/weather"
+	"time"
+)
+
+var ErrQuotaExceeded = errors.New("weatherapi quota exceeded")
+
+type EntryStatus struct {
+	CEP         string     `json:"cep"`
+	Schedule    string     `json:"schedule"`
+	NextRun     time.Time  `json:"next_run"`
+	LastRun     *time.Time `json:"last_run,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	LastTempC   *float64   `json:"last_temp_C,omitempty"`
+	Runs        int        `json:"runs"`
+	Failures    int        `json:"failures"`
+}
+
+type RunStatus struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Sampled    int       `json:"sampled"`
+	Failed     int       `json:"failed"`
+	Skipped    int       `json:"skipped"`
+}
+
+type Status struct {
+	Entries int           `json:"entries"`
+	LastRun *RunStatus    `json:"last_run,omitempty"`
+	Quota   *QuotaStatus  `json:"quota,omitempty"`
+	CEPs    []EntryStatus `json:"ceps"`
+}
+
+type entryState struct {
+	entry  Entry
+	status EntryStatus
+}
+
+// Scheduler samples every watchlist entry on its own schedule, independent
+// of request traffic.
+type Scheduler struct {
+	// Fetch is weather.ObserveByCEP outside of tests.
+	Fetch func(cep string) (*weather.WeatherResponse, weather.Observation, error)
+	Sink  weather.Recorder
+	// Rate caps lookups per second across the whole watchlist; 0 disables it.
+	Rate float64
+	// Jitter spreads each entry's runs by a random delay in [0, Jitter).
+	Jitter time.Duration
+	Quota  *Quota
+	Now    func() time.Time
+	Logger *slog.Logger
+
+	mu        sync.Mutex
+	entries   []*entryState
+	lastRun   *RunStatus
+	lastFetch time.Time
+}
+
+func NewScheduler(entries []Entry, sink weather.Recorder, jitter time.Duration, logger *slog.Logger) *Scheduler {
+	s := &Scheduler{
+		Fetch:  weather.ObserveByCEP,
+		Sink:   sink,
+		Jitter: jitter,
+		Now:    time.Now,
+		Logger: logger,
+	}
+	now := s.Now()
+	for _, entry := range entries {
+		s.entries = append(s.entries, &entryState{
+			entry:  entry,
+			status: EntryStatus{CEP: entry.CEP, Schedule: entry.Spec, NextRun: s.next(entry, now)},
+		})
+	}
+	return s
+}
+
+func (s *Scheduler) next(entry Entry, after time.Time) time.Time {
+	next := entry.Schedule.Next(after)
+	if !next.IsZero() && s.Jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(s.Jitter))))
+	}
+	return next
+}
+
+// Run samples due entries until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	for {
+		wait := time.Minute
+		if next, ok := s.nextRun(); ok {
+			wait = next.Sub(s.Now())
+		}
+
+		timer := time.NewTimer(max(wait, 0))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		s.RunDue(ctx)
+	}
+}
+
+func (s *Scheduler) nextRun() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var next time.Time
+	for _, state := range s.entries {
+		if state.status.NextRun.IsZero() {
+			continue
+		}
+		if next.IsZero() || state.status.NextRun.Before(next) {
+			next = state.status.NextRun
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// RunDue samples every entry whose next run is not in the future, in
+// schedule order, honouring the rate limit and the quota.
+func (s *Scheduler) RunDue(ctx context.Context) RunStatus {
+	run := RunStatus{StartedAt: s.Now()}
+
+	s.mu.Lock()
+	var due []*entryState
+	for _, state := range s.entries {
+		if !state.status.NextRun.IsZero() && !state.status.NextRun.After(run.StartedAt) {
+			due = append(due, state)
+		}
+	}
+	s.mu.Unlock()
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].status.NextRun.Before(due[j].status.NextRun)
+	})
+
+	for _, state := range due {
+		if err := s.throttle(ctx); err != nil {
+			break
+		}
+
+		now := s.Now()
+		var observation weather.Observation
+		var err error
+		if s.Quota != nil && !s.Quota.Take(RequestsPerLookup, now) {
+			err = ErrQuotaExceeded
+			run.Skipped++
+		} else {
+			_, observation, err = s.Fetch(state.entry.CEP)
+			if err == nil && s.Sink != nil {
+				err = s.Sink.Record(observation)
+			}
+			if err != nil {
+				s.Logger.Warn("watchlist: can not sample", slog.String("cep", state.entry.CEP), slog.String("error", err.Error()))
+				run.Failed++
+			} else {
+				run.Sampled++
+			}
+		}
+
+		s.mu.Lock()
+		status := &state.status
+		status.LastRun = &now
+		status.NextRun = s.next(state.entry, now)
+		if err != nil {
+			status.LastError = err.Error()
+			if !errors.Is(err, ErrQuotaExceeded) {
+				status.Runs++
+				status.Failures++
+			}
+		} else {
+			status.Runs++
+			status.LastError = ""
+			status.LastSuccess = &now
+			temp := observation.TempC
+			status.LastTempC = &temp
+		}
+		s.mu.Unlock()
+	}
+
+	run.FinishedAt = s.Now()
+	s.mu.Lock()
+	s.lastRun = &run
+	s.mu.Unlock()
+	return run
+}
+
+func (s *Scheduler) throttle(ctx context.Context) error {
+	if s.Rate <= 0 {
+		return ctx.Err()
+	}
+
+	gap := time.Duration(float64(time.Second) / s.Rate)
+	wait := time.Until(s.lastFetch.Add(gap))
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	s.lastFetch = time.Now()
+	return ctx.Err()
+}
+
+func (s *Scheduler) Status() Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := Status{Entries: len(s.entries), CEPs: []EntryStatus{}}
+	if s.lastRun != nil {
+		run := *s.lastRun
+		status.LastRun = &run
+	}
+	if s.Quota != nil {
+		quota := s.Quota.Status(s.Now())
+		status.Quota = &quota
+	}
+	for _, state := range s.entries {
+		status.CEPs = append(status.CEPs, state.status)
+	}
+	return status
+}
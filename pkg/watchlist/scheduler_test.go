@@ -0,0 +1,172 @@
+package watchlist
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"temperature_server/pkg/weather"
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newTestScheduler(t *testing.T, sink weather.Recorder, ceps ...string) (*Scheduler, *fakeClock, *[]string) {
+	t.Helper()
+	clock := &fakeClock{now: time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)}
+
+	var entries []Entry
+	for _, cep := range ceps {
+		schedule, _ := ParseSchedule("@every 10m")
+		entries = append(entries, Entry{CEP: cep, Spec: "@every 10m", Schedule: schedule})
+	}
+
+	scheduler := NewScheduler(entries, sink, 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scheduler.Now = clock.Now
+	var fetched []string
+	scheduler.Fetch = func(cep string) (*weather.WeatherResponse, weather.Observation, error) {
+		fetched = append(fetched, cep)
+		if cep == "99999999" {
+			return nil, weather.Observation{}, errors.New("can not find zipcode")
+		}
+		return &weather.WeatherResponse{}, weather.Observation{CEP: cep, TempC: 25, ObservedAt: clock.now.Unix()}, nil
+	}
+	// As próximas execuções foram calculadas com o relógio real; realinha ao fake.
+	for _, state := range scheduler.entries {
+		state.status.NextRun = clock.now
+	}
+	return scheduler, clock, &fetched
+}
+
+func TestRunDueSamplesAndReschedules(t *testing.T) {
+	sinkPath := filepath.Join(t.TempDir(), "observations.jsonl")
+	sink, err := NewJSONLSink(sinkPath)
+	if err != nil {
+		t.Fatalf("Failed to open sink: %v", err)
+	}
+	defer sink.Close()
+
+	scheduler, clock, fetched := newTestScheduler(t, sink, "35630016", "99999999")
+	var logs bytes.Buffer
+	scheduler.Logger = slog.New(slog.NewJSONHandler(&logs, nil))
+
+	run := scheduler.RunDue(context.Background())
+	if run.Sampled != 1 || run.Failed != 1 || len(*fetched) != 2 {
+		t.Fatalf("Unexpected run: %+v, fetched %v", run, *fetched)
+	}
+	if !strings.Contains(logs.String(), `"cep":"99999999"`) || !strings.Contains(logs.String(), `"error":"can not find zipcode"`) {
+		t.Errorf("Expected the failed sample to be logged, got %s", logs.String())
+	}
+
+	// Nada vence antes do próximo intervalo.
+	clock.now = clock.now.Add(5 * time.Minute)
+	if run := scheduler.RunDue(context.Background()); run.Sampled+run.Failed+run.Skipped != 0 {
+		t.Errorf("Expected no due entries, got %+v", run)
+	}
+
+	status := scheduler.Status()
+	if status.Entries != 2 || status.LastRun == nil {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+	ok, failed := status.CEPs[0], status.CEPs[1]
+	if ok.LastSuccess == nil || ok.LastTempC == nil || *ok.LastTempC != 25 || ok.Runs != 1 {
+		t.Errorf("Unexpected status for successful entry: %+v", ok)
+	}
+	if failed.LastError == "" || failed.Failures != 1 || failed.LastSuccess != nil {
+		t.Errorf("Unexpected status for failed entry: %+v", failed)
+	}
+	if !ok.NextRun.Equal(time.Date(2025, 7, 9, 10, 10, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected next run: %v", ok.NextRun)
+	}
+
+	file, _ := os.Open(sinkPath)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	var lines int
+	for scanner.Scan() {
+		var obs weather.Observation
+		if err := json.Unmarshal(scanner.Bytes(), &obs); err != nil || obs.CEP != "35630016" {
+			t.Errorf("Unexpected JSONL line %q", scanner.Text())
+		}
+		lines++
+	}
+	if lines != 1 {
+		t.Errorf("Expected 1 JSONL line, got %d", lines)
+	}
+}
+
+func TestRunDueRespectsQuota(t *testing.T) {
+	scheduler, clock, fetched := newTestScheduler(t, nil, "35630016", "01001000", "20040020")
+	// Cota para uma única consulta (busca + clima atual).
+	scheduler.Quota = NewQuota(RequestsPerLookup, time.Hour)
+
+	run := scheduler.RunDue(context.Background())
+	if run.Sampled != 1 || run.Skipped != 2 || len(*fetched) != 1 {
+		t.Fatalf("Unexpected run: %+v, fetched %v", run, *fetched)
+	}
+	if status := scheduler.Status(); status.CEPs[1].LastError != ErrQuotaExceeded.Error() || status.Quota.Used != 2 {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+
+	// A janela seguinte libera a cota.
+	clock.now = clock.now.Add(time.Hour)
+	if run := scheduler.RunDue(context.Background()); run.Sampled != 1 {
+		t.Errorf("Expected one sample after quota reset, got %+v", run)
+	}
+}
+
+func TestRunDueRateLimit(t *testing.T) {
+	scheduler, _, fetched := newTestScheduler(t, nil, "35630016", "01001000", "20040020")
+	scheduler.Rate = 20
+
+	start := time.Now()
+	scheduler.RunDue(context.Background())
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("Expected 3 lookups at 20/s to take at least 100ms, took %v", elapsed)
+	}
+	if len(*fetched) != 3 {
+		t.Errorf("Expected 3 lookups, got %d", len(*fetched))
+	}
+}
+
+func TestJitterDelaysNextRun(t *testing.T) {
+	scheduler, clock, _ := newTestScheduler(t, nil, "35630016")
+	scheduler.Jitter = time.Minute
+
+	scheduler.RunDue(context.Background())
+	next := scheduler.Status().CEPs[0].NextRun
+	base := clock.now.Add(10 * time.Minute)
+	if next.Before(base) || !next.Before(base.Add(time.Minute)) {
+		t.Errorf("Expected next run within [%v, %v), got %v", base, base.Add(time.Minute), next)
+	}
+}
+
+func TestStatusHandler(t *testing.T) {
+	scheduler, _, _ := newTestScheduler(t, nil, "35630016")
+	scheduler.RunDue(context.Background())
+
+	rec := httptest.NewRecorder()
+	StatusHandler(scheduler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/watchlist", nil))
+
+	if rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Unexpected content type %q", rec.Header().Get("Content-Type"))
+	}
+	var status Status
+	if err := json.NewDecoder(rec.Body).Decode(&status); err != nil {
+		t.Fatalf("Failed to decode status: %v", err)
+	}
+	if status.Entries != 1 || status.LastRun == nil || status.LastRun.Sampled != 1 || status.CEPs[0].CEP != "35630016" {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
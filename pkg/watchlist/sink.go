@@ -0,0 +1,39 @@
+package watchlist
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"temperature_server/pkg/weather"
+)
+
+// JSONLSink appends one JSON observation per line to a file. It satisfies
+// weather.Recorder, as does history.Store.
+type JSONLSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewJSONLSink(path string) (*JSONLSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLSink{file: file}, nil
+}
+
+func (s *JSONLSink) Record(obs weather.Observation) error {
+	line, err := json.Marshal(obs)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+func (s *JSONLSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,67 @@
+package watchlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"temperature_server/pkg/cep"
+)
+
+type Entry struct {
+	CEP      string
+	Spec     string
+	Schedule Schedule
+}
+
+// Parse reads a watchlist: one CEP per line, optionally followed by its own
+// schedule, with # starting a comment.
+//
+//	35630-016
+//	01001-000 */15 * * * *
+//	20040-020 @every 30m
+//
+// Lines without a schedule use defaultSpec.
+func Parse(r io.Reader, defaultSpec string) ([]Entry, error) {
+	var entries []Entry
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		parsed, err := cep.Parse(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid zipcode %q", line, fields[0])
+		}
+		code := parsed.Digits()
+		if seen[code] {
+			return nil, fmt.Errorf("line %d: duplicate zipcode %s", line, code)
+		}
+		seen[code] = true
+
+		spec := defaultSpec
+		if len(fields) > 1 {
+			spec = strings.Join(fields[1:], " ")
+		}
+		schedule, err := ParseSchedule(spec)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, Entry{CEP: code, Spec: spec, Schedule: schedule})
+	}
+	return entries, scanner.Err()
+}
+
+func LoadFile(path, defaultSpec string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Parse(file, defaultSpec)
+}
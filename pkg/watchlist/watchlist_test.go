@@ -0,0 +1,45 @@
+package watchlist
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := `
+# lojas
+35630-016
+01001-000 */15 * * * *   # centro
+20040020 @every 30m
+`
+	entries, err := Parse(strings.NewReader(input), "@hourly")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+
+	expected := []Entry{
+		{CEP: "35630016", Spec: "@hourly"},
+		{CEP: "01001000", Spec: "*/15 * * * *"},
+		{CEP: "20040020", Spec: "@every 30m"},
+	}
+	for i, entry := range entries {
+		if entry.CEP != expected[i].CEP || entry.Spec != expected[i].Spec || entry.Schedule == nil {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, expected[i], entry)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, input := range []string{
+		"1234",
+		"35630-016\n35630016",
+		"35630-016 * * *",
+	} {
+		if _, err := Parse(strings.NewReader(input), "@hourly"); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
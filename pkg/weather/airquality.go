@@ -0,0 +1,144 @@
+package weather
+
+import (
+	"errors"
+	"net/http"
+	"temperature_server/pkg/i18n"
+)
+
+var ErrNoAirQuality = errors.New("air quality not available for this location")
+
+type AirQualityCategory struct {
+	Index int    `json:"index"`
+	Level string `json:"level"`
+	// Label is translated to the request's language by the i18n catalogs.
+	Label string `json:"label"`
+}
+
+var usEPACategories = []struct {
+	level string
+	label string
+}{
+	{"good", "Good"},
+	{"moderate", "Moderate"},
+	{"unhealthy_for_sensitive_groups", "Unhealthy for sensitive groups"},
+	{"unhealthy", "Unhealthy"},
+	{"very_unhealthy", "Very unhealthy"},
+	{"hazardous", "Hazardous"},
+}
+
+// USEPACategory labels the US EPA index (1-6) in lang; ok is false outside
+// the scale.
+func USEPACategory(index int, lang string) (AirQualityCategory, bool) {
+	if index < 1 || index > len(usEPACategories) {
+		return AirQualityCategory{Index: index}, false
+	}
+	c := usEPACategories[index-1]
+	return AirQualityCategory{Index: index, Level: c.level, Label: i18n.Message(lang, c.label)}, true
+}
+
+// DefraCategory labels the UK DEFRA Daily Air Quality Index bands in lang:
+// 1-3 low, 4-6 moderate, 7-9 high and 10 very high.
+func DefraCategory(index int, lang string) (AirQualityCategory, bool) {
+	category := AirQualityCategory{Index: index}
+	var label string
+	switch {
+	case index < 1 || index > 10:
+		return category, false
+	case index <= 3:
+		category.Level, label = "low", "Low"
+	case index <= 6:
+		category.Level, label = "moderate", "Moderate"
+	case index <= 9:
+		category.Level, label = "high", "High"
+	default:
+		category.Level, label = "very_high", "Very high"
+	}
+	category.Label = i18n.Message(lang, label)
+	return category, true
+}
+
+type Pollutants struct {
+	CO    float64 `json:"co"`
+	NO2   float64 `json:"no2"`
+	O3    float64 `json:"o3"`
+	SO2   float64 `json:"so2"`
+	PM2_5 float64 `json:"pm2_5"`
+	PM10  float64 `json:"pm10"`
+}
+
+type AirQualityResponse struct {
+	ObservedAt int64               `json:"observed_at"`
+	Unit       string              `json:"unit"`
+	Pollutants Pollutants          `json:"pollutants"`
+	USEPA      *AirQualityCategory `json:"us_epa,omitempty"`
+	GBDefra    *AirQualityCategory `json:"gb_defra,omitempty"`
+}
+
+func NewAirQualityResponse(current Current, lang string) (*AirQualityResponse, error) {
+	aq := current.AirQuality
+	if aq == nil {
+		return nil, ErrNoAirQuality
+	}
+
+	response := &AirQualityResponse{
+		ObservedAt: current.LastUpdatedEpoch,
+		Unit:       "μg/m³",
+		Pollutants: Pollutants{
+			CO:    aq.CO,
+			NO2:   aq.NO2,
+			O3:    aq.O3,
+			SO2:   aq.SO2,
+			PM2_5: aq.PM2_5,
+			PM10:  aq.PM10,
+		},
+	}
+	if category, ok := USEPACategory(aq.USEPAIndex, lang); ok {
+		response.USEPA = &category
+	}
+	if category, ok := DefraCategory(aq.GBDefraIndex, lang); ok {
+		response.GBDefra = &category
+	}
+	return response, nil
+}
+
+func GetAirQualityByCEP(cep string, lang string) (*AirQualityResponse, error) {
+	weatherData, err := GetWeatherByCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAirQualityResponse(weatherData.Current, lang)
+}
+
+func AirQualityHandler(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	cep := r.URL.Query().Get("cep")
+	if cep == "" {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	lang := i18n.FromRequest(r)
+	response, err := GetAirQualityByCEP(cep, lang)
+	if errors.Is(err, ErrNoAirQuality) {
+		WriteError(w, r, format, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		WriteServiceError(w, r, format, err)
+		return
+	}
+
+	w.Header().Set("Content-Language", lang)
+	writeObservation(w, r, format, response.ObservedAt, response)
+}
@@ -0,0 +1,112 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"temperature_server/pkg/i18n"
+	"testing"
+)
+
+func TestUSEPACategory(t *testing.T) {
+	tests := []struct {
+		index int
+		lang  string
+		level string
+		label string
+	}{
+		{1, i18n.Portuguese, "good", "Boa"},
+		{3, i18n.Portuguese, "unhealthy_for_sensitive_groups", "Insalubre para grupos sensíveis"},
+		{3, i18n.Spanish, "unhealthy_for_sensitive_groups", "Dañina para grupos sensibles"},
+		{6, i18n.English, "hazardous", "Hazardous"},
+	}
+	for _, test := range tests {
+		category, ok := USEPACategory(test.index, test.lang)
+		if !ok || category.Level != test.level || category.Label != test.label {
+			t.Errorf("Index %d (%s): unexpected category %+v", test.index, test.lang, category)
+		}
+	}
+	if _, ok := USEPACategory(0, i18n.English); ok {
+		t.Error("Expected index 0 to be outside the scale")
+	}
+}
+
+func TestDefraCategory(t *testing.T) {
+	for index, level := range map[int]string{1: "low", 3: "low", 4: "moderate", 7: "high", 9: "high", 10: "very_high"} {
+		category, ok := DefraCategory(index, i18n.English)
+		if !ok || category.Level != level {
+			t.Errorf("Index %d: expected %s, got %+v", index, level, category)
+		}
+	}
+	if category, _ := DefraCategory(10, i18n.Spanish); category.Label != "Muy alta" {
+		t.Errorf("Expected Spanish label, got %+v", category)
+	}
+	if _, ok := DefraCategory(11, i18n.English); ok {
+		t.Error("Expected index 11 to be outside the scale")
+	}
+}
+
+func TestAirQualityHandler(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	rec := httptest.NewRecorder()
+	AirQualityHandler(rec, httptest.NewRequest(http.MethodGet, "/air-quality?cep=35630-016", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response AirQualityResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Pollutants.PM2_5 != 12.6 || response.Pollutants.CO != 230.3 {
+		t.Errorf("Unexpected pollutants: %+v", response.Pollutants)
+	}
+	if response.USEPA == nil || response.USEPA.Label != "Moderate" {
+		t.Errorf("Unexpected US EPA category: %+v", response.USEPA)
+	}
+	if response.GBDefra == nil || response.GBDefra.Level != "low" {
+		t.Errorf("Unexpected DEFRA category: %+v", response.GBDefra)
+	}
+}
+
+func TestAirQualityHandler_Localized(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/air-quality?cep=35630-016", nil)
+	req.Header.Set("Accept-Language", "es-AR,es;q=0.9")
+	AirQualityHandler(rec, req)
+
+	var response AirQualityResponse
+	json.NewDecoder(rec.Body).Decode(&response)
+	if response.USEPA == nil || response.USEPA.Label != "Moderada" || response.GBDefra == nil || response.GBDefra.Label != "Baja" {
+		t.Errorf("Expected Spanish labels, got %+v and %+v", response.USEPA, response.GBDefra)
+	}
+	if rec.Header().Get("Content-Language") != i18n.Spanish {
+		t.Errorf("Expected Content-Language es, got %q", rec.Header().Get("Content-Language"))
+	}
+}
+
+func TestAirQualityHandler_NotAvailable(t *testing.T) {
+	weatherData := sampleWeather()
+	weatherData.Current.AirQuality = nil
+	newUpstreamServer(t, weatherData)
+
+	rec := httptest.NewRecorder()
+	AirQualityHandler(rec, httptest.NewRequest(http.MethodGet, "/air-quality?cep=35630-016", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+}
+
+func TestAirQualityHandler_MissingCEP(t *testing.T) {
+	rec := httptest.NewRecorder()
+	AirQualityHandler(rec, httptest.NewRequest(http.MethodGet, "/air-quality", nil))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+}
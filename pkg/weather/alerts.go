@@ -0,0 +1,228 @@
+package weather
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"temperature_server/pkg/render"
+	"time"
+)
+
+// AlertsCacheTTL is how long alerts for a location are reused before
+// WeatherAPI is asked again.
+var AlertsCacheTTL = 5 * time.Minute
+
+type WeatherAlert struct {
+	Headline    string `json:"headline"`
+	MsgType     string `json:"msgtype"`
+	Severity    string `json:"severity"`
+	Urgency     string `json:"urgency"`
+	Areas       string `json:"areas"`
+	Category    string `json:"category"`
+	Certainty   string `json:"certainty"`
+	Event       string `json:"event"`
+	Note        string `json:"note"`
+	Effective   string `json:"effective"`
+	Expires     string `json:"expires"`
+	Desc        string `json:"desc"`
+	Instruction string `json:"instruction"`
+}
+
+type ForecastAlertsResponse struct {
+	Location Location `json:"location"`
+	Alerts   struct {
+		Alert []WeatherAlert `json:"alert"`
+	} `json:"alerts"`
+}
+
+func FetchAlertsData(lat float64, lon float64) (*ForecastAlertsResponse, error) {
+	query := url.Values{"q": {coordinates(lat, lon)}, "days": {"1"}, "alerts": {"yes"}}
+	var alertsResponse ForecastAlertsResponse
+	if err := getJSON("/forecast.json", query, &alertsResponse); err != nil {
+		return nil, err
+	}
+
+	return &alertsResponse, nil
+}
+
+type ActiveAlert struct {
+	Event       string     `json:"event"`
+	Headline    string     `json:"headline"`
+	Severity    string     `json:"severity"`
+	Urgency     string     `json:"urgency"`
+	Certainty   string     `json:"certainty"`
+	Areas       []string   `json:"areas"`
+	Effective   *time.Time `json:"effective,omitempty"`
+	Expires     *time.Time `json:"expires,omitempty"`
+	InEffect    bool       `json:"in_effect"`
+	Description string     `json:"description"`
+	Instruction string     `json:"instruction,omitempty"`
+}
+
+type AlertsResponse struct {
+	Location string        `json:"location"`
+	Region   string        `json:"region"`
+	Alerts   []ActiveAlert `json:"alerts"`
+}
+
+var severityRank = map[string]int{"extreme": 4, "severe": 3, "moderate": 2, "minor": 1}
+
+func parseAlertTime(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func splitAreas(areas string) []string {
+	result := []string{}
+	for _, area := range strings.Split(areas, ";") {
+		if area = strings.TrimSpace(area); area != "" {
+			result = append(result, area)
+		}
+	}
+	return result
+}
+
+// NewAlertsResponse keeps the alerts that have not expired at now, merges
+// the copies WeatherAPI sends once per affected area into a single alert,
+// and orders them by severity and then by start time. Alerts that start in
+// the future are kept with in_effect false.
+func NewAlertsResponse(data ForecastAlertsResponse, now time.Time) *AlertsResponse {
+	response := &AlertsResponse{
+		Location: data.Location.Name,
+		Region:   data.Location.Region,
+		Alerts:   []ActiveAlert{},
+	}
+
+	index := map[string]int{}
+	for _, alert := range data.Alerts.Alert {
+		effective, expires := parseAlertTime(alert.Effective), parseAlertTime(alert.Expires)
+		if expires != nil && !expires.After(now) {
+			continue
+		}
+
+		key := strings.ToLower(strings.Join([]string{alert.Event, alert.Headline, alert.Severity, alert.Effective, alert.Expires}, "|"))
+		if i, ok := index[key]; ok {
+			existing := &response.Alerts[i]
+			for _, area := range splitAreas(alert.Areas) {
+				if !containsString(existing.Areas, area) {
+					existing.Areas = append(existing.Areas, area)
+				}
+			}
+			continue
+		}
+
+		index[key] = len(response.Alerts)
+		response.Alerts = append(response.Alerts, ActiveAlert{
+			Event:       alert.Event,
+			Headline:    alert.Headline,
+			Severity:    alert.Severity,
+			Urgency:     alert.Urgency,
+			Certainty:   alert.Certainty,
+			Areas:       splitAreas(alert.Areas),
+			Effective:   effective,
+			Expires:     expires,
+			InEffect:    effective == nil || !effective.After(now),
+			Description: alert.Desc,
+			Instruction: alert.Instruction,
+		})
+	}
+
+	sort.SliceStable(response.Alerts, func(i, j int) bool {
+		a, b := response.Alerts[i], response.Alerts[j]
+		rankA, rankB := severityRank[strings.ToLower(a.Severity)], severityRank[strings.ToLower(b.Severity)]
+		if rankA != rankB {
+			return rankA > rankB
+		}
+		if a.Effective != nil && b.Effective != nil {
+			return a.Effective.Before(*b.Effective)
+		}
+		return false
+	})
+	return response
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type cachedAlerts struct {
+	data    *ForecastAlertsResponse
+	expires time.Time
+}
+
+var alertsCache = struct {
+	sync.Mutex
+	entries map[string]cachedAlerts
+}{entries: map[string]cachedAlerts{}}
+
+// GetAlertsByCEP returns the alerts for the CEP's municipality, reusing the
+// upstream payload for AlertsCacheTTL.
+func GetAlertsByCEP(cep string) (*AlertsResponse, error) {
+	_, searchData, err := locateCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	key := fmt.Sprintf("%.4f,%.4f", searchData.Lat, searchData.Lon)
+
+	alertsCache.Lock()
+	cached, ok := alertsCache.entries[key]
+	alertsCache.Unlock()
+
+	if !ok || now.After(cached.expires) {
+		data, err := FetchAlertsData(searchData.Lat, searchData.Lon)
+		if err != nil {
+			return nil, fmt.Errorf("can not find alerts: %w", err)
+		}
+		cached = cachedAlerts{data: data, expires: now.Add(AlertsCacheTTL)}
+
+		alertsCache.Lock()
+		for k, entry := range alertsCache.entries {
+			if now.After(entry.expires) {
+				delete(alertsCache.entries, k)
+			}
+		}
+		alertsCache.entries[key] = cached
+		alertsCache.Unlock()
+	}
+
+	return NewAlertsResponse(*cached.data, now), nil
+}
+
+func AlertsHandler(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	cep := r.URL.Query().Get("cep")
+	if cep == "" {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	response, err := GetAlertsByCEP(cep)
+	if err != nil {
+		WriteServiceError(w, r, format, err)
+		return
+	}
+
+	render.Write(w, format, http.StatusOK, response)
+}
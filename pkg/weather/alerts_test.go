@@ -0,0 +1,97 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func loadAlertsFixture(t *testing.T) ForecastAlertsResponse {
+	t.Helper()
+	body, err := os.ReadFile("testdata/forecast_alerts.json")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	var data ForecastAlertsResponse
+	if err := json.Unmarshal(body, &data); err != nil {
+		t.Fatalf("Failed to decode fixture: %v", err)
+	}
+	return data
+}
+
+func TestNewAlertsResponse_MultiAlert(t *testing.T) {
+	data := loadAlertsFixture(t)
+	if len(data.Alerts.Alert) != 4 {
+		t.Fatalf("Expected 4 alerts in fixture, got %d", len(data.Alerts.Alert))
+	}
+
+	now := time.Date(2025, 7, 9, 15, 0, 0, 0, time.UTC)
+	response := NewAlertsResponse(data, now)
+
+	// A baixa umidade já expirou e as duas cópias da tempestade viram uma só.
+	if len(response.Alerts) != 2 {
+		t.Fatalf("Expected 2 alerts, got %+v", response.Alerts)
+	}
+
+	heatWave, storm := response.Alerts[0], response.Alerts[1]
+	if heatWave.Event != "Onda de Calor" || heatWave.Severity != "Severe" || heatWave.InEffect {
+		t.Errorf("Expected upcoming severe heat wave first, got %+v", heatWave)
+	}
+	if storm.Event != "Tempestade" || !storm.InEffect {
+		t.Errorf("Expected storm in effect, got %+v", storm)
+	}
+	expectedAreas := []string{"Central Mineira", "Oeste de Minas", "Metropolitana de Belo Horizonte"}
+	if !reflect.DeepEqual(storm.Areas, expectedAreas) {
+		t.Errorf("Expected merged areas %v, got %v", expectedAreas, storm.Areas)
+	}
+	if storm.Effective == nil || !storm.Effective.Equal(time.Date(2025, 7, 9, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected effective time %v", storm.Effective)
+	}
+	if storm.Expires == nil || storm.Expires.Year() != 2099 {
+		t.Errorf("Unexpected expiry time %v", storm.Expires)
+	}
+}
+
+func TestNewAlertsResponse_NoAlerts(t *testing.T) {
+	response := NewAlertsResponse(ForecastAlertsResponse{}, time.Now())
+	if response.Alerts == nil || len(response.Alerts) != 0 {
+		t.Errorf("Expected empty alert list, got %v", response.Alerts)
+	}
+}
+
+func TestAlertsHandler_Cached(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		AlertsHandler(rec, httptest.NewRequest(http.MethodGet, "/alerts?cep=35630-016", nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var response AlertsResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if response.Location != "Bom Despacho" || len(response.Alerts) != 2 {
+			t.Errorf("Unexpected response: %+v", response)
+		}
+	}
+
+	if calls := alertsRequests.Load(); calls != 1 {
+		t.Errorf("Expected 1 forecast.json call, got %d", calls)
+	}
+}
+
+func TestAlertsHandler_MissingCEP(t *testing.T) {
+	rec := httptest.NewRecorder()
+	AlertsHandler(rec, httptest.NewRequest(http.MethodGet, "/alerts", nil))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,232 @@
+package weather
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"temperature_server/pkg/calculations"
+	"temperature_server/pkg/render"
+	"time"
+)
+
+const (
+	SourceWeatherAPI = "weatherapi"
+	SourceCalculated = "calculated"
+)
+
+type Astro struct {
+	Sunrise          string      `json:"sunrise"`
+	Sunset           string      `json:"sunset"`
+	Moonrise         string      `json:"moonrise"`
+	Moonset          string      `json:"moonset"`
+	MoonPhase        string      `json:"moon_phase"`
+	MoonIllumination json.Number `json:"moon_illumination"`
+	IsMoonUp         int         `json:"is_moon_up"`
+	IsSunUp          int         `json:"is_sun_up"`
+}
+
+type AstronomyData struct {
+	Location  Location `json:"location"`
+	Astronomy struct {
+		Astro Astro `json:"astro"`
+	} `json:"astronomy"`
+}
+
+func FetchAstronomyData(lat float64, lon float64, date time.Time) (*AstronomyData, error) {
+	query := url.Values{"q": {coordinates(lat, lon)}, "dt": {date.Format(DateLayout)}}
+	var astronomyData AstronomyData
+	if err := getJSON("/astronomy.json", query, &astronomyData); err != nil {
+		return nil, err
+	}
+
+	return &astronomyData, nil
+}
+
+// ufTimeZones maps each state to the zone covering its capital. States not
+// listed follow America/Sao_Paulo.
+var ufTimeZones = map[string]string{
+	"AC": "America/Rio_Branco",
+	"AM": "America/Manaus",
+	"RR": "America/Boa_Vista",
+	"RO": "America/Porto_Velho",
+	"MT": "America/Cuiaba",
+	"MS": "America/Campo_Grande",
+	"PA": "America/Belem",
+	"AP": "America/Belem",
+	"TO": "America/Araguaina",
+	"MA": "America/Fortaleza",
+	"PI": "America/Fortaleza",
+	"CE": "America/Fortaleza",
+	"RN": "America/Fortaleza",
+	"PB": "America/Fortaleza",
+	"PE": "America/Recife",
+	"AL": "America/Maceio",
+	"SE": "America/Maceio",
+	"BA": "America/Bahia",
+}
+
+// TimeZoneForUF returns the IANA zone used when WeatherAPI's tz_id is not
+// available.
+func TimeZoneForUF(uf string) string {
+	if zone, ok := ufTimeZones[strings.ToUpper(uf)]; ok {
+		return zone
+	}
+	return "America/Sao_Paulo"
+}
+
+type AstronomyResponse struct {
+	Date             string     `json:"date"`
+	TimeZone         string     `json:"time_zone"`
+	Source           string     `json:"source"`
+	Sunrise          *time.Time `json:"sunrise"`
+	Sunset           *time.Time `json:"sunset"`
+	DaylightMinutes  int        `json:"daylight_minutes"`
+	Moonrise         *time.Time `json:"moonrise,omitempty"`
+	Moonset          *time.Time `json:"moonset,omitempty"`
+	MoonPhase        string     `json:"moon_phase"`
+	MoonIllumination float64    `json:"moon_illumination"`
+}
+
+func (a *AstronomyResponse) setDaylight() {
+	if a.Sunrise != nil && a.Sunset != nil {
+		a.DaylightMinutes = int(a.Sunset.Sub(*a.Sunrise).Round(time.Minute).Minutes())
+	}
+}
+
+// parseLocalClock turns WeatherAPI's "06:36 AM" into a time on date in loc;
+// values such as "No moonrise" yield nil.
+func parseLocalClock(date time.Time, value string, loc *time.Location) *time.Time {
+	t, err := time.ParseInLocation("2006-01-02 03:04 PM", date.Format(DateLayout)+" "+strings.TrimSpace(value), loc)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func NewAstronomyResponse(data AstronomyData, date time.Time) (*AstronomyResponse, error) {
+	loc, err := time.LoadLocation(data.Location.TzID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", data.Location.TzID, err)
+	}
+
+	astro := data.Astronomy.Astro
+	illumination, _ := astro.MoonIllumination.Float64()
+	response := &AstronomyResponse{
+		Date:             date.Format(DateLayout),
+		TimeZone:         loc.String(),
+		Source:           SourceWeatherAPI,
+		Sunrise:          parseLocalClock(date, astro.Sunrise, loc),
+		Sunset:           parseLocalClock(date, astro.Sunset, loc),
+		Moonrise:         parseLocalClock(date, astro.Moonrise, loc),
+		Moonset:          parseLocalClock(date, astro.Moonset, loc),
+		MoonPhase:        astro.MoonPhase,
+		MoonIllumination: illumination,
+	}
+	response.setDaylight()
+	return response, nil
+}
+
+// CalculateAstronomy is the offline fallback: sun times from
+// calculations.SunTimes and the moon phase at local noon. Moonrise and
+// moonset are not estimated.
+func CalculateAstronomy(lat, lon float64, date time.Time, loc *time.Location) *AstronomyResponse {
+	local := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, loc)
+	sun := calculations.SunTimes(local, lat, lon)
+	phase, illumination := calculations.MoonPhase(local)
+
+	response := &AstronomyResponse{
+		Date:             date.Format(DateLayout),
+		TimeZone:         loc.String(),
+		Source:           SourceCalculated,
+		MoonPhase:        phase,
+		MoonIllumination: illumination,
+	}
+	if !sun.Sunrise.IsZero() {
+		sunrise, sunset := sun.Sunrise.In(loc), sun.Sunset.In(loc)
+		response.Sunrise, response.Sunset = &sunrise, &sunset
+	}
+	if sun.PolarDay {
+		response.DaylightMinutes = 24 * 60
+	}
+	response.setDaylight()
+	return response
+}
+
+// GetAstronomyByCEP asks WeatherAPI for the CEP's astronomy on date (today
+// in the location when zero) and falls back to CalculateAstronomy when the
+// upstream call fails or source is SourceCalculated.
+func GetAstronomyByCEP(cep string, date time.Time, source string) (*AstronomyResponse, error) {
+	cepData, searchData, err := locateCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(TimeZoneForUF(cepData.UF))
+	if err != nil {
+		return nil, err
+	}
+	if date.IsZero() {
+		date = time.Now().In(loc)
+	}
+
+	if source != SourceCalculated {
+		data, err := FetchAstronomyData(searchData.Lat, searchData.Lon, date)
+		if err == nil {
+			var response *AstronomyResponse
+			if response, err = NewAstronomyResponse(*data, date); err == nil {
+				return response, nil
+			}
+		}
+		if source == SourceWeatherAPI {
+			return nil, fmt.Errorf("can not find astronomy: %w", err)
+		}
+		slog.Warn("astronomy: using offline calculation", slog.String("error", err.Error()))
+	}
+
+	return CalculateAstronomy(searchData.Lat, searchData.Lon, date, loc), nil
+}
+
+func AstronomyHandler(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	cep := query.Get("cep")
+	if cep == "" {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	var date time.Time
+	if value := query.Get("date"); value != "" {
+		var err error
+		if date, err = time.Parse(DateLayout, value); err != nil {
+			WriteError(w, r, format, http.StatusBadRequest, "invalid date")
+			return
+		}
+	}
+
+	source := query.Get("source")
+	if source != "" && source != SourceWeatherAPI && source != SourceCalculated {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid source")
+		return
+	}
+
+	response, err := GetAstronomyByCEP(cep, date, source)
+	if err != nil {
+		WriteServiceError(w, r, format, err)
+		return
+	}
+
+	render.Write(w, format, http.StatusOK, response)
+}
@@ -0,0 +1,127 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestTimeZoneForUF(t *testing.T) {
+	for uf, zone := range map[string]string{"MG": "America/Sao_Paulo", "am": "America/Manaus", "AC": "America/Rio_Branco", "XX": "America/Sao_Paulo"} {
+		if got := TimeZoneForUF(uf); got != zone {
+			t.Errorf("%s: expected %s, got %s", uf, zone, got)
+		}
+	}
+}
+
+// TestCalculateAstronomyMatchesWeatherAPI compara o cálculo offline com a
+// resposta da WeatherAPI do fixture.
+func TestCalculateAstronomyMatchesWeatherAPI(t *testing.T) {
+	body, err := os.ReadFile("testdata/astronomy.json")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	var data AstronomyData
+	if err := json.Unmarshal(body, &data); err != nil {
+		t.Fatalf("Failed to decode fixture: %v", err)
+	}
+
+	date := time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC)
+	upstream, err := NewAstronomyResponse(data, date)
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	loc, _ := time.LoadLocation(data.Location.TzID)
+	calculated := CalculateAstronomy(data.Location.Lat, data.Location.Lon, date, loc)
+
+	if upstream.Source != SourceWeatherAPI || calculated.Source != SourceCalculated {
+		t.Errorf("Unexpected sources %s and %s", upstream.Source, calculated.Source)
+	}
+	if upstream.Sunrise.Format(time.RFC3339) != "2025-07-09T06:35:00-03:00" {
+		t.Errorf("Expected sunrise in local time, got %v", upstream.Sunrise)
+	}
+
+	tolerance := 3 * time.Minute
+	if diff := calculated.Sunrise.Sub(*upstream.Sunrise); diff < -tolerance || diff > tolerance {
+		t.Errorf("Sunrise differs by %v", diff)
+	}
+	if diff := calculated.Sunset.Sub(*upstream.Sunset); diff < -tolerance || diff > tolerance {
+		t.Errorf("Sunset differs by %v", diff)
+	}
+	if diff := calculated.DaylightMinutes - upstream.DaylightMinutes; diff < -5 || diff > 5 {
+		t.Errorf("Daylight differs by %d minutes", diff)
+	}
+	if diff := calculated.MoonIllumination - upstream.MoonIllumination; diff < -5 || diff > 5 {
+		t.Errorf("Moon illumination differs by %.0f%%", diff)
+	}
+}
+
+func TestAstronomyHandler(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	for _, source := range []string{"", SourceCalculated} {
+		rec := httptest.NewRecorder()
+		AstronomyHandler(rec, httptest.NewRequest(http.MethodGet, "/astronomy?cep=35630-016&date=2025-07-09&source="+source, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var response AstronomyResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+
+		expected := source
+		if expected == "" {
+			expected = SourceWeatherAPI
+		}
+		if response.Source != expected || response.TimeZone != "America/Sao_Paulo" || response.Sunrise == nil {
+			t.Errorf("Unexpected response: %+v", response)
+		}
+		if _, offset := response.Sunrise.Zone(); offset != -3*60*60 {
+			t.Errorf("Expected sunrise in local time, got %v", response.Sunrise)
+		}
+	}
+}
+
+func TestAstronomyHandler_Fallback(t *testing.T) {
+	upstream := newUpstreamServer(t, sampleWeather())
+
+	// Encaminha tudo ao mock, exceto astronomy.json, que falha.
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/astronomy.json" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		http.Redirect(w, r, upstream.URL+"/v1"+r.URL.RequestURI(), http.StatusTemporaryRedirect)
+	}))
+	defer failing.Close()
+	BaseURL = failing.URL
+
+	rec := httptest.NewRecorder()
+	AstronomyHandler(rec, httptest.NewRequest(http.MethodGet, "/astronomy?cep=35630-016&date=2025-07-09", nil))
+	var response AstronomyResponse
+	json.NewDecoder(rec.Body).Decode(&response)
+	if rec.Code != http.StatusOK || response.Source != SourceCalculated {
+		t.Errorf("Expected calculated fallback, got %d %+v", rec.Code, response)
+	}
+
+	rec = httptest.NewRecorder()
+	AstronomyHandler(rec, httptest.NewRequest(http.MethodGet, "/astronomy?cep=35630-016&date=2025-07-09&source=weatherapi", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500 when WeatherAPI is required, got %d", rec.Code)
+	}
+}
+
+func TestAstronomyHandler_InvalidParams(t *testing.T) {
+	for _, query := range []string{"", "cep=35630-016&date=09-07-2025", "cep=35630-016&source=moon"} {
+		rec := httptest.NewRecorder()
+		AstronomyHandler(rec, httptest.NewRequest(http.MethodGet, "/astronomy?"+query, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%q: expected status 400, got %d", query, rec.Code)
+		}
+	}
+}
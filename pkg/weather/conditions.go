@@ -0,0 +1,112 @@
+package weather
+
+import (
+	"net/http"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/utils"
+)
+
+type Measurement struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+type ConditionsResponse struct {
+	Units         utils.UnitSystem `json:"units"`
+	ObservedAt    int64            `json:"observed_at"`
+	Condition     string           `json:"condition"`
+	Temperature   Measurement      `json:"temperature"`
+	FeelsLike     Measurement      `json:"feels_like"`
+	Humidity      int              `json:"humidity"`
+	WindSpeed     Measurement      `json:"wind_speed"`
+	WindGust      Measurement      `json:"wind_gust"`
+	WindDegree    int              `json:"wind_degree"`
+	WindDir       string           `json:"wind_dir"`
+	Beaufort      int              `json:"beaufort"`
+	Pressure      Measurement      `json:"pressure"`
+	Precipitation Measurement      `json:"precipitation"`
+	Visibility    Measurement      `json:"visibility"`
+}
+
+// NewConditionsResponse only reads the metric fields of Current and
+// converts them, so providers that report a single unit work the same way.
+func NewConditionsResponse(current Current, system utils.UnitSystem) *ConditionsResponse {
+	units := system.Units()
+
+	temperature := func(celsius float64) Measurement {
+		t := utils.FromCelsius(celsius).To(units.Temperature)
+		return Measurement{Value: utils.RoundTo(t.Value, 2), Unit: units.Temperature.Symbol()}
+	}
+	speed := func(kph float64) Measurement {
+		s := utils.NewSpeed(kph, utils.KilometersPerHour).To(units.Speed)
+		return Measurement{Value: utils.RoundTo(s.Value, 2), Unit: string(units.Speed)}
+	}
+	length := func(l utils.Length, unit utils.LengthUnit) Measurement {
+		return Measurement{Value: utils.RoundTo(l.To(unit).Value, 2), Unit: string(unit)}
+	}
+	pressure := utils.NewPressure(current.PressureMb, utils.Hectopascal).To(units.Pressure)
+
+	return &ConditionsResponse{
+		Units:         system,
+		ObservedAt:    current.LastUpdatedEpoch,
+		Condition:     current.Condition.Text,
+		Temperature:   temperature(current.TempC),
+		FeelsLike:     temperature(current.FeelslikeC),
+		Humidity:      current.Humidity,
+		WindSpeed:     speed(current.WindKph),
+		WindGust:      speed(current.GustKph),
+		WindDegree:    current.WindDegree,
+		WindDir:       current.WindDir,
+		Beaufort:      utils.NewSpeed(current.WindKph, utils.KilometersPerHour).BeaufortForce(),
+		Pressure:      Measurement{Value: utils.RoundTo(pressure.Value, 2), Unit: string(units.Pressure)},
+		Precipitation: length(utils.NewLength(current.PrecipMm, utils.Millimeters), units.Precipitation),
+		Visibility:    length(utils.NewLength(current.VisKm, utils.Kilometers), units.Visibility),
+	}
+}
+
+// GetConditionsByCEP returns the current conditions with the condition
+// text translated to lang.
+func GetConditionsByCEP(cep string, system utils.UnitSystem, lang string) (*ConditionsResponse, error) {
+	weatherData, err := GetWeatherByCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NewConditionsResponse(weatherData.Current, system)
+	response.Condition = weatherData.Current.LocalizedCondition(lang)
+	return response, nil
+}
+
+func WeatherHandler(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	cep := r.URL.Query().Get("cep")
+	if cep == "" {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	system, err := utils.ParseUnitSystem(r.URL.Query().Get("units"))
+	if err != nil {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid unit system")
+		return
+	}
+
+	lang := i18n.FromRequest(r)
+	response, err := GetConditionsByCEP(cep, system, lang)
+	if err != nil {
+		WriteServiceError(w, r, format, err)
+		return
+	}
+
+	w.Header().Set("Content-Language", lang)
+	writeObservation(w, r, format, response.ObservedAt, response)
+}
@@ -0,0 +1,147 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"temperature_server/pkg/utils"
+	"testing"
+)
+
+func TestNewConditionsResponse(t *testing.T) {
+	current := sampleWeather().Current
+
+	tests := []struct {
+		system        utils.UnitSystem
+		temperature   Measurement
+		windSpeed     Measurement
+		pressure      Measurement
+		precipitation string
+		visibility    Measurement
+	}{
+		{
+			system:        utils.Metric,
+			temperature:   Measurement{Value: 25, Unit: "°C"},
+			windSpeed:     Measurement{Value: 10.1, Unit: "km/h"},
+			pressure:      Measurement{Value: 1013, Unit: "hPa"},
+			precipitation: "mm",
+			visibility:    Measurement{Value: 10, Unit: "km"},
+		},
+		{
+			system:        utils.Imperial,
+			temperature:   Measurement{Value: 77, Unit: "°F"},
+			windSpeed:     Measurement{Value: 6.28, Unit: "mph"},
+			pressure:      Measurement{Value: 29.91, Unit: "inHg"},
+			precipitation: "in",
+			visibility:    Measurement{Value: 6.21, Unit: "mi"},
+		},
+		{
+			system:        utils.SI,
+			temperature:   Measurement{Value: 298.15, Unit: "K"},
+			windSpeed:     Measurement{Value: 2.81, Unit: "m/s"},
+			pressure:      Measurement{Value: 101.3, Unit: "kPa"},
+			precipitation: "mm",
+			visibility:    Measurement{Value: 10000, Unit: "m"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.system), func(t *testing.T) {
+			response := NewConditionsResponse(current, tt.system)
+
+			if response.Temperature != tt.temperature {
+				t.Errorf("Expected temperature %+v, got %+v", tt.temperature, response.Temperature)
+			}
+			if response.WindSpeed != tt.windSpeed {
+				t.Errorf("Expected wind speed %+v, got %+v", tt.windSpeed, response.WindSpeed)
+			}
+			if response.Pressure != tt.pressure {
+				t.Errorf("Expected pressure %+v, got %+v", tt.pressure, response.Pressure)
+			}
+			if response.Precipitation.Unit != tt.precipitation {
+				t.Errorf("Expected precipitation unit %s, got %s", tt.precipitation, response.Precipitation.Unit)
+			}
+			if response.Visibility != tt.visibility {
+				t.Errorf("Expected visibility %+v, got %+v", tt.visibility, response.Visibility)
+			}
+			if response.Beaufort != 2 {
+				t.Errorf("Expected Beaufort 2, got %d", response.Beaufort)
+			}
+		})
+	}
+}
+
+func TestWeatherHandler(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	server := httptest.NewServer(http.HandlerFunc(WeatherHandler))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/weather?cep=35630016&units=imperial")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var response ConditionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Units != utils.Imperial {
+		t.Errorf("Expected units imperial, got %s", response.Units)
+	}
+	if response.Temperature.Unit != "°F" {
+		t.Errorf("Expected temperature in °F, got %s", response.Temperature.Unit)
+	}
+}
+
+func TestWeatherHandler_InvalidUnits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(WeatherHandler))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/weather?cep=35630016&units=nautical")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+
+	var errorResp ErrorResponse
+	json.NewDecoder(resp.Body).Decode(&errorResp)
+	if errorResp.Error != "invalid unit system" {
+		t.Errorf("Expected error 'invalid unit system', got %s", errorResp.Error)
+	}
+}
+
+func TestWeatherHandler_Localized(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/weather?cep=35630-016", nil)
+	req.Header.Set("Accept-Language", "pt-BR,pt;q=0.9,en;q=0.8")
+	WeatherHandler(rec, req)
+
+	var response ConditionsResponse
+	json.NewDecoder(rec.Body).Decode(&response)
+	if response.Condition != "Parcialmente nublado" {
+		t.Errorf("Expected condition in Portuguese, got %q", response.Condition)
+	}
+	if rec.Header().Get("Content-Language") != "pt-BR" {
+		t.Errorf("Expected Content-Language pt-BR, got %q", rec.Header().Get("Content-Language"))
+	}
+
+	rec = httptest.NewRecorder()
+	WeatherHandler(rec, httptest.NewRequest(http.MethodGet, "/weather?lang=es&units=kelvin", nil))
+	var errorResponse ErrorResponse
+	json.NewDecoder(rec.Body).Decode(&errorResponse)
+	if errorResponse.Error != "código postal inválido" {
+		t.Errorf("Expected error in Spanish, got %q", errorResponse.Error)
+	}
+}
@@ -0,0 +1,33 @@
+package weather
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MaxForecastDays is the longest forecast WeatherAPI's free plan serves.
+const MaxForecastDays = 3
+
+type ForecastResponse struct {
+	Location Location `json:"location"`
+	Forecast struct {
+		Forecastday []ForecastDay `json:"forecastday"`
+	} `json:"forecast"`
+}
+
+// FetchForecastData returns the daily and hourly forecast for the next days
+// (1 to MaxForecastDays), starting today.
+func FetchForecastData(lat float64, lon float64, days int) (*ForecastResponse, error) {
+	if days < 1 || days > MaxForecastDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", MaxForecastDays)
+	}
+
+	query := url.Values{"q": {coordinates(lat, lon)}, "days": {strconv.Itoa(days)}}
+	var forecastResponse ForecastResponse
+	if err := getJSON("/forecast.json", query, &forecastResponse); err != nil {
+		return nil, err
+	}
+
+	return &forecastResponse, nil
+}
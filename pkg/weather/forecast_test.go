@@ -0,0 +1,33 @@
+package weather
+
+import "testing"
+
+func TestFetchForecastData(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	forecast, err := FetchForecastData(-19.72, -45.25, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	days := forecast.Forecast.Forecastday
+	if len(days) != 3 {
+		t.Fatalf("Expected 3 forecast days, got %d", len(days))
+	}
+	if days[0].Date != "2025-07-10" || days[2].Day.MaxtempC != 29 {
+		t.Errorf("Unexpected forecast: %+v", days)
+	}
+	// Sem alerts=yes a chamada não conta como busca de alertas.
+	if calls := alertsRequests.Load(); calls != 0 {
+		t.Errorf("Expected no alerts requests, got %d", calls)
+	}
+}
+
+func TestFetchForecastData_DaysOutOfRange(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	for _, days := range []int{0, MaxForecastDays + 1} {
+		if _, err := FetchForecastData(-19.72, -45.25, days); err == nil {
+			t.Errorf("Expected error for %d days", days)
+		}
+	}
+}
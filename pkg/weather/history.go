@@ -0,0 +1,272 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"temperature_server/pkg/render"
+	"temperature_server/pkg/utils"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	DateLayout = "2006-01-02"
+	// DefaultHistoryDays matches the look-back of WeatherAPI's free plan;
+	// paid plans raise it through WEATHER_HISTORY_DAYS.
+	DefaultHistoryDays = 7
+	historyCacheSize   = 512
+)
+
+// EarliestHistoryDate is the oldest date WeatherAPI's history.json serves.
+var EarliestHistoryDate = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var ErrDateOutOfRange = errors.New("date out of range")
+
+type HistoryResponse struct {
+	Location Location `json:"location"`
+	Forecast struct {
+		Forecastday []ForecastDay `json:"forecastday"`
+	} `json:"forecast"`
+}
+
+type ForecastDay struct {
+	Date      string `json:"date"`
+	DateEpoch int64  `json:"date_epoch"`
+	Day       Day    `json:"day"`
+	Hour      []Hour `json:"hour"`
+}
+
+type Day struct {
+	MaxtempC      float64   `json:"maxtemp_c"`
+	MaxtempF      float64   `json:"maxtemp_f"`
+	MintempC      float64   `json:"mintemp_c"`
+	MintempF      float64   `json:"mintemp_f"`
+	AvgtempC      float64   `json:"avgtemp_c"`
+	AvgtempF      float64   `json:"avgtemp_f"`
+	MaxwindKph    float64   `json:"maxwind_kph"`
+	TotalprecipMm float64   `json:"totalprecip_mm"`
+	AvgvisKm      float64   `json:"avgvis_km"`
+	Avghumidity   float64   `json:"avghumidity"`
+	Uv            float64   `json:"uv"`
+	Condition     Condition `json:"condition"`
+}
+
+type Hour struct {
+	TimeEpoch  int64     `json:"time_epoch"`
+	Time       string    `json:"time"`
+	TempC      float64   `json:"temp_c"`
+	TempF      float64   `json:"temp_f"`
+	IsDay      int       `json:"is_day"`
+	Condition  Condition `json:"condition"`
+	WindKph    float64   `json:"wind_kph"`
+	WindDegree int       `json:"wind_degree"`
+	WindDir    string    `json:"wind_dir"`
+	PressureMb float64   `json:"pressure_mb"`
+	PrecipMm   float64   `json:"precip_mm"`
+	Humidity   int       `json:"humidity"`
+	Cloud      int       `json:"cloud"`
+	FeelslikeC float64   `json:"feelslike_c"`
+	DewpointC  float64   `json:"dewpoint_c"`
+	VisKm      float64   `json:"vis_km"`
+	GustKph    float64   `json:"gust_kph"`
+	Uv         float64   `json:"uv"`
+}
+
+// HourAt returns the hourly reading starting at hour (0-23).
+func (d ForecastDay) HourAt(hour int) (Hour, bool) {
+	for _, h := range d.Hour {
+		t, err := time.Parse("2006-01-02 15:04", h.Time)
+		if err == nil && t.Hour() == hour {
+			return h, true
+		}
+	}
+	return Hour{}, false
+}
+
+func FetchHistoryData(lat float64, lon float64, date time.Time) (*HistoryResponse, error) {
+	query := url.Values{"q": {coordinates(lat, lon)}, "dt": {date.Format(DateLayout)}}
+	var historyResponse HistoryResponse
+	if err := getJSON("/history.json", query, &historyResponse); err != nil {
+		return nil, err
+	}
+	if len(historyResponse.Forecast.Forecastday) == 0 {
+		return nil, errors.New("no history for the given date")
+	}
+
+	return &historyResponse, nil
+}
+
+// HistoryRange returns the first and last dates accepted for history
+// queries, relative to now.
+func HistoryRange(now time.Time) (time.Time, time.Time) {
+	days := viper.GetInt("WEATHER_HISTORY_DAYS")
+	if days <= 0 {
+		days = DefaultHistoryDays
+	}
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	first := today.AddDate(0, 0, -days)
+	if first.Before(EarliestHistoryDate) {
+		first = EarliestHistoryDate
+	}
+	return first, today
+}
+
+// isImmutable reports whether a date is far enough in the past to be
+// complete in every timezone, so its history never changes again.
+func isImmutable(date, now time.Time) bool {
+	_, today := HistoryRange(now)
+	return date.Before(today.AddDate(0, 0, -1))
+}
+
+type historyCache struct {
+	mu      sync.Mutex
+	entries map[string]*HistoryResponse
+	order   []string
+}
+
+var pastHistory = &historyCache{entries: map[string]*HistoryResponse{}}
+
+func (c *historyCache) get(key string) (*HistoryResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *historyCache) put(key string, value *HistoryResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	if len(c.order) >= historyCacheSize {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.entries[key] = value
+	c.order = append(c.order, key)
+}
+
+// GetHistoryByCEP returns WeatherAPI's history for the CEP on date. Results
+// for dates that can no longer change are cached in memory.
+func GetHistoryByCEP(cep string, date time.Time) (*HistoryResponse, error) {
+	first, last := HistoryRange(time.Now())
+	if date.Before(first) || date.After(last) {
+		return nil, fmt.Errorf("%w: %s/%s", ErrDateOutOfRange, first.Format(DateLayout), last.Format(DateLayout))
+	}
+
+	_, searchData, err := locateCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%.4f,%.4f,%s", searchData.Lat, searchData.Lon, date.Format(DateLayout))
+	if cached, ok := pastHistory.get(key); ok {
+		return cached, nil
+	}
+
+	historyData, err := FetchHistoryData(searchData.Lat, searchData.Lon, date)
+	if err != nil {
+		return nil, fmt.Errorf("can not find history: %w", err)
+	}
+
+	if isImmutable(date, time.Now()) {
+		pastHistory.put(key, historyData)
+	}
+	return historyData, nil
+}
+
+type HourlyTemperature struct {
+	Time string `json:"time"`
+	*TemperatureResponse
+}
+
+type DailyTemperatureResponse struct {
+	Date   string               `json:"date"`
+	Min    *TemperatureResponse `json:"min"`
+	Max    *TemperatureResponse `json:"max"`
+	Avg    *TemperatureResponse `json:"avg"`
+	Hourly []HourlyTemperature  `json:"hourly"`
+}
+
+type HourlyTemperatureResponse struct {
+	Date string `json:"date"`
+	Hour int    `json:"hour"`
+	Time string `json:"time"`
+	*TemperatureResponse
+}
+
+func NewDailyTemperatureResponse(day ForecastDay) DailyTemperatureResponse {
+	response := DailyTemperatureResponse{
+		Date:   day.Date,
+		Min:    NewTemperatureResponse(utils.FromCelsius(day.Day.MintempC)),
+		Max:    NewTemperatureResponse(utils.FromCelsius(day.Day.MaxtempC)),
+		Avg:    NewTemperatureResponse(utils.FromCelsius(day.Day.AvgtempC)),
+		Hourly: []HourlyTemperature{},
+	}
+	for _, hour := range day.Hour {
+		response.Hourly = append(response.Hourly, HourlyTemperature{
+			Time:                hour.Time,
+			TemperatureResponse: NewTemperatureResponse(utils.FromCelsius(hour.TempC)),
+		})
+	}
+	return response
+}
+
+// historicalTemperatureHandler serves /temperature?cep=&date=[&hour=].
+func historicalTemperatureHandler(w http.ResponseWriter, r *http.Request, format *render.Format, cep string) {
+	query := r.URL.Query()
+	date, err := time.Parse(DateLayout, query.Get("date"))
+	if err != nil {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid date")
+		return
+	}
+
+	hour := -1
+	if value := query.Get("hour"); value != "" {
+		hour, err = strconv.Atoi(value)
+		if err != nil || hour < 0 || hour > 23 {
+			WriteError(w, r, format, http.StatusBadRequest, "invalid hour")
+			return
+		}
+	}
+
+	historyData, err := GetHistoryByCEP(cep, date)
+	if errors.Is(err, ErrDateOutOfRange) {
+		WriteError(w, r, format, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		WriteServiceError(w, r, format, err)
+		return
+	}
+
+	if isImmutable(date, time.Now()) {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+
+	day := historyData.Forecast.Forecastday[0]
+	if hour < 0 {
+		render.Write(w, format, http.StatusOK, NewDailyTemperatureResponse(day))
+		return
+	}
+
+	reading, ok := day.HourAt(hour)
+	if !ok {
+		WriteError(w, r, format, http.StatusNotFound, "hour not available")
+		return
+	}
+	render.Write(w, format, http.StatusOK, HourlyTemperatureResponse{
+		Date:                day.Date,
+		Hour:                hour,
+		Time:                reading.Time,
+		TemperatureResponse: NewTemperatureResponse(utils.FromCelsius(reading.TempC)),
+	})
+}
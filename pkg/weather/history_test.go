@@ -0,0 +1,112 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetchHistoryData_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"code":1008,"message":"API key is limited to get history data."}}`))
+	}))
+	defer server.Close()
+	newUpstreamServer(t, sampleWeather())
+	BaseURL = server.URL
+
+	_, err := FetchHistoryData(-19.72, -45.25, time.Now())
+	if err == nil || err.Error() != "API key is limited to get history data." {
+		t.Errorf("Expected WeatherAPI error message, got %v", err)
+	}
+}
+
+func TestHistoryRange(t *testing.T) {
+	now := time.Date(2025, 7, 9, 23, 30, 0, 0, time.UTC)
+	first, last := HistoryRange(now)
+
+	if !first.Equal(time.Date(2025, 7, 2, 0, 0, 0, 0, time.UTC)) || !last.Equal(time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected range %v - %v", first, last)
+	}
+	if isImmutable(last.AddDate(0, 0, -1), now) || !isImmutable(last.AddDate(0, 0, -2), now) {
+		t.Error("Expected only dates before yesterday to be immutable")
+	}
+}
+
+func TestTemperatureHandler_Daily(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+	date := time.Now().UTC().AddDate(0, 0, -3).Format(DateLayout)
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		TemperatureHandler(rec, httptest.NewRequest(http.MethodGet, "/temperature?cep=35630-016&date="+date, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if rec.Header().Get("Cache-Control") == "" {
+			t.Error("Expected Cache-Control for a past date")
+		}
+
+		var response DailyTemperatureResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if response.Date != date || response.Min.Temp_C != 15 || response.Max.Temp_C != 26.5 || response.Avg.Temp_K != 293.9 {
+			t.Errorf("Unexpected daily response: %+v", response)
+		}
+		if len(response.Hourly) != 24 || response.Hourly[2].Temp_C != 16 {
+			t.Errorf("Unexpected hourly temperatures: %+v", response.Hourly)
+		}
+	}
+
+	// A segunda consulta de uma data passada vem do cache.
+	if calls := historyRequests.Load(); calls != 1 {
+		t.Errorf("Expected 1 history.json call, got %d", calls)
+	}
+}
+
+func TestTemperatureHandler_Hour(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+	date := time.Now().UTC().Format(DateLayout)
+
+	rec := httptest.NewRecorder()
+	TemperatureHandler(rec, httptest.NewRequest(http.MethodGet, "/temperature?cep=35630-016&date="+date+"&hour=9", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Cache-Control") != "" {
+		t.Error("Expected no Cache-Control for today")
+	}
+
+	var response HourlyTemperatureResponse
+	json.NewDecoder(rec.Body).Decode(&response)
+	if response.Hour != 9 || response.Time != date+" 09:00" || response.Temp_C != 19.5 || response.Temp_F != 67.1 {
+		t.Errorf("Unexpected hourly response: %+v", response)
+	}
+}
+
+func TestTemperatureHandler_InvalidDate(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Format(DateLayout)
+
+	for _, query := range []string{
+		"date=09/07/2025",
+		"date=2009-12-31",
+		"date=" + tomorrow,
+		"date=" + time.Now().UTC().Format(DateLayout) + "&hour=24",
+		"hour=10",
+	} {
+		rec := httptest.NewRecorder()
+		TemperatureHandler(rec, httptest.NewRequest(http.MethodGet, "/temperature?cep=35630-016&"+query, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", query, rec.Code)
+		}
+	}
+	if calls := historyRequests.Load(); calls != 0 {
+		t.Errorf("Expected no history.json calls, got %d", calls)
+	}
+}
@@ -0,0 +1,67 @@
+package weather
+
+import (
+	"net/http"
+	"temperature_server/pkg/calculations"
+)
+
+type IndicesResponse struct {
+	TempC    float64 `json:"temp_C"`
+	Humidity int     `json:"humidity"`
+	WindKph  float64 `json:"wind_kph"`
+	calculations.Indices
+}
+
+func NewIndicesResponse(current Current) *IndicesResponse {
+	indices := calculations.Compute(calculations.Conditions{
+		TempC:    current.TempC,
+		Humidity: float64(current.Humidity),
+		WindKph:  current.WindKph,
+	})
+	indices.HeatIndex.Compare(current.HeatindexC)
+	indices.WindChill.Compare(current.WindchillC)
+	indices.DewPoint.Compare(current.DewpointC)
+	indices.ApparentTemperature.Compare(current.FeelslikeC)
+
+	return &IndicesResponse{
+		TempC:    current.TempC,
+		Humidity: current.Humidity,
+		WindKph:  current.WindKph,
+		Indices:  indices,
+	}
+}
+
+func GetIndicesByCEP(cep string) (*IndicesResponse, error) {
+	weatherData, err := GetWeatherByCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndicesResponse(weatherData.Current), nil
+}
+
+func IndicesHandler(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	cep := r.URL.Query().Get("cep")
+	if cep == "" {
+		WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	weatherData, err := GetWeatherByCEP(cep)
+	if err != nil {
+		WriteServiceError(w, r, format, err)
+		return
+	}
+
+	writeObservation(w, r, format, weatherData.Current.LastUpdatedEpoch, NewIndicesResponse(weatherData.Current))
+}
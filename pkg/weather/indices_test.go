@@ -0,0 +1,79 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"temperature_server/pkg/calculations"
+	"testing"
+)
+
+func TestNewIndicesResponse(t *testing.T) {
+	current := sampleWeather().Current
+	response := NewIndicesResponse(current)
+
+	if response.TempC != current.TempC {
+		t.Errorf("Expected temp_C %f, got %f", current.TempC, response.TempC)
+	}
+
+	if response.HeatIndex.ProviderC == nil || *response.HeatIndex.ProviderC != current.HeatindexC {
+		t.Errorf("Expected provider heat index %f, got %v", current.HeatindexC, response.HeatIndex.ProviderC)
+	}
+	if response.WindChill.ProviderC == nil || *response.WindChill.ProviderC != current.WindchillC {
+		t.Errorf("Expected provider wind chill %f, got %v", current.WindchillC, response.WindChill.ProviderC)
+	}
+
+	// Índices sem equivalente na WeatherAPI não devem ter comparação
+	if response.Humidex.ProviderC != nil || response.WBGT.ProviderC != nil {
+		t.Error("Expected humidex and WBGT to have no provider comparison")
+	}
+}
+
+func TestIndicesHandler(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	server := httptest.NewServer(http.HandlerFunc(IndicesHandler))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/indices?cep=35630-016")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	for _, field := range []string{"temp_C", "heat_index", "wind_chill", "dew_point", "humidex", "apparent_temperature", "wbgt"} {
+		if _, ok := body[field]; !ok {
+			t.Errorf("Expected field %s in response", field)
+		}
+	}
+
+	var heatIndex calculations.Index
+	json.Unmarshal(body["heat_index"], &heatIndex)
+	if heatIndex.Risk != calculations.RiskNone {
+		t.Errorf("Expected heat index risk %s, got %s", calculations.RiskNone, heatIndex.Risk)
+	}
+}
+
+func TestIndicesHandler_MissingCEP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(IndicesHandler))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/indices")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+}
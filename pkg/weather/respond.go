@@ -0,0 +1,83 @@
+package weather
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"temperature_server/pkg/i18n"
+	"temperature_server/pkg/render"
+	"time"
+)
+
+// negotiate resolves the response format before any upstream call is made,
+// answering 406 in JSON when none of the registered formats is acceptable.
+func negotiate(w http.ResponseWriter, r *http.Request) (*render.Format, bool) {
+	w.Header().Add("Vary", "Accept")
+	w.Header().Add("Vary", "Accept-Language")
+	format, err := render.Negotiate(r)
+	if err != nil {
+		WriteError(w, r, render.JSON, http.StatusNotAcceptable, "not acceptable")
+		return nil, false
+	}
+	return format, true
+}
+
+// WriteError renders an ErrorResponse with message translated to the
+// request's language (see i18n.FromRequest).
+func WriteError(w http.ResponseWriter, r *http.Request, format *render.Format, status int, message string) {
+	lang := i18n.FromRequest(r)
+	w.Header().Set("Content-Language", lang)
+	render.Write(w, format, status, ErrorResponse{Error: i18n.Message(lang, message)})
+}
+
+// throttled is implemented by errors from rate-limited upstream calls
+// (ratelimit.LimitError).
+type throttled interface {
+	RetryAfter() time.Duration
+}
+
+// WriteServiceError answers 429 with Retry-After when err comes from an
+// upstream call that was throttled, and 500 otherwise.
+func WriteServiceError(w http.ResponseWriter, r *http.Request, format *render.Format, err error) {
+	var limited throttled
+	if errors.As(err, &limited) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(limited.RetryAfter().Seconds()))))
+		WriteError(w, r, format, http.StatusTooManyRequests, "upstream rate limit exceeded")
+		return
+	}
+	WriteError(w, r, format, http.StatusInternalServerError, err.Error())
+}
+
+// RefreshInterval is how often WeatherAPI publishes a new current reading.
+var RefreshInterval = 15 * time.Minute
+
+// minMaxAge keeps readings that are overdue for a refresh briefly cacheable,
+// since the provider may still return the same one.
+const minMaxAge = time.Minute
+
+// ObservationMaxAge is how long a reading taken at observed stays fresh:
+// until the next expected refresh, never less than minMaxAge.
+func ObservationMaxAge(observed, now time.Time) time.Duration {
+	maxAge := observed.Add(RefreshInterval).Sub(now).Truncate(time.Second)
+	if maxAge < minMaxAge {
+		return minMaxAge
+	}
+	if maxAge > RefreshInterval {
+		return RefreshInterval
+	}
+	return maxAge
+}
+
+// writeObservation renders a response derived from the reading taken at
+// observedAt (Current.LastUpdatedEpoch) with validators from that time, so
+// clients and CDNs can reuse it and revalidate with If-None-Match or
+// If-Modified-Since.
+func writeObservation(w http.ResponseWriter, r *http.Request, format *render.Format, observedAt int64, v interface{}) {
+	var validators render.Validators
+	if observedAt > 0 {
+		observed := time.Unix(observedAt, 0)
+		validators = render.Validators{LastModified: observed, MaxAge: ObservationMaxAge(observed, time.Now())}
+	}
+	render.WriteConditional(w, r, format, v, validators)
+}
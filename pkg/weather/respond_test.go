@@ -0,0 +1,93 @@
+package weather
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestObservationMaxAge(t *testing.T) {
+	observed := time.Date(2025, 7, 9, 21, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected time.Duration
+	}{
+		{"just published", observed, RefreshInterval},
+		{"mid interval", observed.Add(5*time.Minute + 500*time.Millisecond), 9*time.Minute + 59*time.Second},
+		{"overdue", observed.Add(time.Hour), minMaxAge},
+		{"clock skew", observed.Add(-time.Hour), RefreshInterval},
+	}
+	for _, tt := range tests {
+		if got := ObservationMaxAge(observed, tt.now); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestConditionalRequests(t *testing.T) {
+	data := sampleWeather()
+	newUpstreamServer(t, data)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/temperature", TemperatureHandler)
+	mux.HandleFunc("/weather", WeatherHandler)
+	mux.HandleFunc("/indices", IndicesHandler)
+	mux.HandleFunc("/air-quality", AirQualityHandler)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	lastModified := time.Unix(data.Current.LastUpdatedEpoch, 0).UTC().Format(http.TimeFormat)
+
+	for _, path := range []string{"/temperature", "/weather", "/indices", "/air-quality"} {
+		t.Run(path, func(t *testing.T) {
+			get := func(header http.Header) (*http.Response, string) {
+				req, _ := http.NewRequest(http.MethodGet, server.URL+path+"?cep=35630016", nil)
+				for k, v := range header {
+					req.Header[k] = v
+				}
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					t.Fatalf("Failed to make request: %v", err)
+				}
+				defer resp.Body.Close()
+				body, _ := io.ReadAll(resp.Body)
+				return resp, string(body)
+			}
+
+			resp, body := get(nil)
+			etag := resp.Header.Get("ETag")
+			if resp.StatusCode != http.StatusOK || etag == "" || body == "" {
+				t.Fatalf("Unexpected response: %d %v", resp.StatusCode, resp.Header)
+			}
+			if resp.Header.Get("Last-Modified") != lastModified {
+				t.Errorf("Expected Last-Modified %s, got %s", lastModified, resp.Header.Get("Last-Modified"))
+			}
+			if !strings.HasPrefix(resp.Header.Get("Cache-Control"), "public, max-age=") {
+				t.Errorf("Unexpected Cache-Control %q", resp.Header.Get("Cache-Control"))
+			}
+
+			resp, body = get(http.Header{"If-None-Match": {etag}})
+			if resp.StatusCode != http.StatusNotModified || body != "" {
+				t.Errorf("Expected an empty 304 for If-None-Match, got %d %q", resp.StatusCode, body)
+			}
+			if resp.Header.Get("ETag") != etag {
+				t.Errorf("Expected the 304 to repeat the ETag, got %q", resp.Header.Get("ETag"))
+			}
+
+			resp, _ = get(http.Header{"If-Modified-Since": {lastModified}})
+			if resp.StatusCode != http.StatusNotModified {
+				t.Errorf("Expected 304 for If-Modified-Since, got %d", resp.StatusCode)
+			}
+
+			resp, _ = get(http.Header{"If-None-Match": {etag}, "Accept": {"text/csv"}})
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected another representation to be sent in full, got %d", resp.StatusCode)
+			}
+		})
+	}
+}
@@ -24,7 +24,7 @@ type Search struct {
 func FecthSearchFromWeatherAPI(city string) (*Search, error) {
 
 	city = strings.ReplaceAll(city, " ", "+")
-	url := fmt.Sprintf("https://api.weatherapi.com/v1/search.json?q=%s", city)
+	url := fmt.Sprintf("%s/search.json?q=%s", BaseURL, city)
 	fmt.Println(url)
 
 	req, err := http.NewRequest("GET", url, nil)
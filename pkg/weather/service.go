@@ -1,9 +1,10 @@
 package weather
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
 	"temperature_server/pkg/utils"
 	"temperature_server/pkg/viacep"
 )
@@ -14,60 +15,154 @@ type TemperatureResponse struct {
 	Temp_K float64 `json:"temp_K"`
 }
 
+func (t TemperatureResponse) Text() string {
+	return fmt.Sprintf("temperature: %g °C / %g °F / %g K", t.Temp_C, t.Temp_F, t.Temp_K)
+}
+
+func NewTemperatureResponse(t utils.Temperature) *TemperatureResponse {
+	return &TemperatureResponse{
+		Temp_C: t.Celsius(),
+		Temp_F: t.Fahrenheit(),
+		Temp_K: t.Kelvin(),
+	}
+}
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
 
-func GetTemperatureByCEP(cep string) (*TemperatureResponse, error) {
+// Observation is what a Recorder receives for every successful lookup.
+type Observation struct {
+	CEP        string  `json:"cep"`
+	IBGE       string  `json:"ibge"`
+	Lat        float64 `json:"lat"`
+	Lon        float64 `json:"lon"`
+	TempC      float64 `json:"temp_C"`
+	Humidity   int     `json:"humidity"`
+	Condition  string  `json:"condition"`
+	ObservedAt int64   `json:"observed_at"`
+}
+
+type Recorder interface {
+	Record(Observation) error
+}
+
+var recorder Recorder
+
+// SetRecorder enables recording of every reading returned by
+// GetWeatherByCEP; nil disables it.
+func SetRecorder(r Recorder) {
+	recorder = r
+}
+
+// locateCEP resolves a CEP to its ViaCEP address and WeatherAPI location.
+func locateCEP(cep string) (*viacep.CEPResponse, *Search, error) {
 	cepData, err := viacep.FetchCEPData(cep)
 	if err != nil {
-		return nil, fmt.Errorf("can not find zipcode: %w", err)
+		return nil, nil, fmt.Errorf("can not find zipcode: %w", err)
 	}
 
 	searchData, err := FecthSearchFromWeatherAPI(cepData.Localidade)
 	if err != nil {
-		return nil, fmt.Errorf("can not find city: %w", err)
+		return nil, nil, fmt.Errorf("can not find city: %w", err)
+	}
+
+	return cepData, searchData, nil
+}
+
+// ObserveByCEP runs the ViaCEP → WeatherAPI pipeline and returns the reading
+// together with its Observation, without passing it to the Recorder.
+func ObserveByCEP(cep string) (*WeatherResponse, Observation, error) {
+	_, weatherData, observation, err := observe(cep)
+	return weatherData, observation, err
+}
+
+func observe(cep string) (*viacep.CEPResponse, *WeatherResponse, Observation, error) {
+	cepData, searchData, err := locateCEP(cep)
+	if err != nil {
+		return nil, nil, Observation{}, err
 	}
 
 	weatherData, err := FetchWeatherData(searchData.Lat, searchData.Lon)
 	if err != nil {
-		return nil, fmt.Errorf("can not find zipcode: %w", err)
+		return nil, nil, Observation{}, fmt.Errorf("can not find zipcode: %w", err)
 	}
 
-	formattedTemp := utils.FormatTemperatures(weatherData.Current.TempC)
+	return cepData, weatherData, Observation{
+		CEP:        strings.ReplaceAll(cepData.CEP, "-", ""),
+		IBGE:       cepData.IBGE,
+		Lat:        weatherData.Location.Lat,
+		Lon:        weatherData.Location.Lon,
+		TempC:      weatherData.Current.TempC,
+		Humidity:   weatherData.Current.Humidity,
+		Condition:  weatherData.Current.Condition.Text,
+		ObservedAt: weatherData.Current.LastUpdatedEpoch,
+	}, nil
+}
 
-	response := &TemperatureResponse{
-		Temp_C: formattedTemp["temp_C"],
-		Temp_F: formattedTemp["temp_F"],
-		Temp_K: formattedTemp["temp_K"],
+// GetReadingByCEP is GetWeatherByCEP that also returns the ViaCEP address
+// the CEP resolved to.
+func GetReadingByCEP(cep string) (*viacep.CEPResponse, *WeatherResponse, error) {
+	cepData, weatherData, observation, err := observe(cep)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return response, nil
+	if recorder != nil {
+		if err := recorder.Record(observation); err != nil {
+			slog.Warn("can not record observation", slog.String("cep", observation.CEP), slog.String("error", err.Error()))
+		}
+	}
+
+	return cepData, weatherData, nil
+}
+
+func GetWeatherByCEP(cep string) (*WeatherResponse, error) {
+	_, weatherData, err := GetReadingByCEP(cep)
+	return weatherData, err
+}
+
+func GetTemperatureByCEP(cep string) (*TemperatureResponse, error) {
+	weatherData, err := GetWeatherByCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTemperatureResponse(utils.FromCelsius(weatherData.Current.TempC)), nil
 }
 
 func TemperatureHandler(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
+	format, ok := negotiate(w, r)
+	if !ok {
+		return
+	}
 
 	if r.Method != http.MethodGet {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		json.NewEncoder(w).Encode(ErrorResponse{Error: "method not allowed"})
+		WriteError(w, r, format, http.StatusMethodNotAllowed, "method not allowed")
 		return
 	}
 
 	cep := r.URL.Query().Get("cep")
 	if cep == "" {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(ErrorResponse{Error: "invalid zipcode"})
+		WriteError(w, r, format, http.StatusBadRequest, "invalid zipcode")
+		return
+	}
+
+	if r.URL.Query().Has("date") {
+		historicalTemperatureHandler(w, r, format, cep)
+		return
+	}
+	if r.URL.Query().Has("hour") {
+		WriteError(w, r, format, http.StatusBadRequest, "hour requires date")
 		return
 	}
 
-	response, err := GetTemperatureByCEP(cep)
+	weatherData, err := GetWeatherByCEP(cep)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		json.NewEncoder(w).Encode(ErrorResponse{Error: err.Error()})
+		WriteServiceError(w, r, format, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(response)
+	response := NewTemperatureResponse(utils.FromCelsius(weatherData.Current.TempC))
+	writeObservation(w, r, format, weatherData.Current.LastUpdatedEpoch, response)
 }
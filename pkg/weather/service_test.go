@@ -1,12 +1,16 @@
 package weather
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetTemperatureByCEP_ValidCEP(t *testing.T) {
@@ -305,3 +309,140 @@ func TestTemperatureResponse_JSONMarshal(t *testing.T) {
 		t.Errorf("Expected Temp_K %f, got %f", response.Temp_K, decodedResponse.Temp_K)
 	}
 }
+
+func TestTemperatureHandler_ContentNegotiation(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	server := httptest.NewServer(http.HandlerFunc(TemperatureHandler))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		accept      string
+		query       string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{name: "json", accept: "application/json", query: "cep=35630016", status: http.StatusOK, contentType: "application/json", contains: `"temp_C":25`},
+		{name: "xml", accept: "application/xml", query: "cep=35630016", status: http.StatusOK, contentType: "application/xml", contains: "<temperature>"},
+		{name: "csv", accept: "text/csv", query: "cep=35630016", status: http.StatusOK, contentType: "text/csv", contains: "temp_C,temp_F,temp_K\n25,77,298.15"},
+		{name: "yaml", accept: "application/yaml", query: "cep=35630016", status: http.StatusOK, contentType: "application/yaml", contains: "temp_K: 298.15"},
+		{name: "texto", accept: "text/plain", query: "cep=35630016", status: http.StatusOK, contentType: "text/plain", contains: "temperature: 25 °C / 77 °F / 298.15 K"},
+		{name: "query format", accept: "application/json", query: "cep=35630016&format=csv", status: http.StatusOK, contentType: "text/csv", contains: "temp_C"},
+		{name: "erro em xml", accept: "application/xml", query: "cep=", status: http.StatusBadRequest, contentType: "application/xml", contains: "<error>invalid zipcode</error>"},
+		{name: "erro em csv", accept: "text/csv", query: "cep=", status: http.StatusBadRequest, contentType: "text/csv", contains: "error\ninvalid zipcode"},
+		{name: "não aceitável", accept: "image/png", query: "cep=35630016", status: http.StatusNotAcceptable, contentType: "application/json", contains: `"error":"not acceptable"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, server.URL+"/temperature?"+tt.query, nil)
+			req.Header.Set("Accept", tt.accept)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Failed to make request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+			if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, tt.contentType) {
+				t.Errorf("Expected content type %s, got %s", tt.contentType, contentType)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if !strings.Contains(string(body), tt.contains) {
+				t.Errorf("Expected body to contain %q, got %s", tt.contains, body)
+			}
+		})
+	}
+}
+
+type recorderFunc func(Observation) error
+
+func (f recorderFunc) Record(obs Observation) error { return f(obs) }
+
+func TestGetWeatherByCEPRecordsObservation(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	var recorded []Observation
+	SetRecorder(recorderFunc(func(obs Observation) error {
+		recorded = append(recorded, obs)
+		return nil
+	}))
+	defer SetRecorder(nil)
+
+	if _, err := GetWeatherByCEP("35630-016"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(recorded) != 1 {
+		t.Fatalf("Expected 1 observation, got %d", len(recorded))
+	}
+	obs := recorded[0]
+	if obs.CEP != "35630016" || obs.IBGE != "3107406" {
+		t.Errorf("Expected CEP 35630016 and IBGE 3107406, got %s and %s", obs.CEP, obs.IBGE)
+	}
+	if obs.TempC != 25.0 || obs.Humidity != 60 || obs.ObservedAt != 1752106500 {
+		t.Errorf("Unexpected observation: %+v", obs)
+	}
+}
+
+func TestGetWeatherByCEPLogsRecorderErrors(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+
+	SetRecorder(recorderFunc(func(obs Observation) error {
+		return errors.New("disk full")
+	}))
+	defer SetRecorder(nil)
+	var logs bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+	defer slog.SetDefault(defaultLogger)
+
+	// A falha ao gravar não impede a resposta
+	if _, err := GetWeatherByCEP("35630-016"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(logs.String(), `"cep":"35630016"`) || !strings.Contains(logs.String(), `"error":"disk full"`) {
+		t.Errorf("Expected the recorder error to be logged, got %s", logs.String())
+	}
+}
+
+type throttledError struct{ wait time.Duration }
+
+func (e throttledError) Error() string             { return "upstream rate limit exceeded" }
+func (e throttledError) RetryAfter() time.Duration { return e.wait }
+
+type throttlingTransport struct{ path string }
+
+func (t throttlingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == t.path {
+		return nil, throttledError{wait: 1500 * time.Millisecond}
+	}
+	return throttlingTransportBase.RoundTrip(req)
+}
+
+var throttlingTransportBase = http.DefaultTransport
+
+func TestTemperatureHandler_UpstreamThrottled(t *testing.T) {
+	newUpstreamServer(t, sampleWeather())
+	http.DefaultTransport = throttlingTransport{path: "/v1/current.json"}
+	defer func() { http.DefaultTransport = throttlingTransportBase }()
+
+	req := httptest.NewRequest(http.MethodGet, "/temperature?cep=35630016", nil)
+	rec := httptest.NewRecorder()
+	TemperatureHandler(rec, req)
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d", rec.Code)
+	}
+	if retryAfter := rec.Header().Get("Retry-After"); retryAfter != "2" {
+		t.Errorf("Expected Retry-After 2, got %q", retryAfter)
+	}
+	if !strings.Contains(rec.Body.String(), "upstream rate limit exceeded") {
+		t.Errorf("Unexpected body: %s", rec.Body.String())
+	}
+}
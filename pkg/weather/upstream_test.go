@@ -0,0 +1,139 @@
+package weather
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"temperature_server/internal/upstreamtest"
+	"temperature_server/pkg/viacep"
+	"testing"
+	"time"
+)
+
+// sampleWeather é a resposta de clima usada pelos servidores mock.
+func sampleWeather() WeatherResponse {
+	return WeatherResponse{
+		Location: Location{
+			Name:           "Bom Despacho",
+			Region:         "Minas Gerais",
+			Country:        "Brazil",
+			Lat:            -19.72,
+			Lon:            -45.25,
+			TzID:           "America/Sao_Paulo",
+			LocaltimeEpoch: 1752107290,
+			Localtime:      "2025-07-09 21:28",
+		},
+		Current: Current{
+			LastUpdatedEpoch: 1752106500,
+			LastUpdated:      "2025-07-09 21:15",
+			TempC:            25.0,
+			TempF:            77.0,
+			Condition:        Condition{Text: "Partly cloudy", Code: 1003},
+			WindKph:          10.1,
+			WindMph:          6.3,
+			PressureMb:       1013.0,
+			PressureIn:       29.91,
+			Humidity:         60,
+			FeelslikeC:       26.1,
+			WindchillC:       25.0,
+			HeatindexC:       25.9,
+			DewpointC:        16.7,
+			VisKm:            10.0,
+			VisMiles:         6.0,
+			AirQuality: &AirQuality{
+				CO:           230.3,
+				NO2:          4.1,
+				O3:           52.0,
+				SO2:          1.5,
+				PM2_5:        12.6,
+				PM10:         18.2,
+				USEPAIndex:   2,
+				GBDefraIndex: 2,
+			},
+		},
+	}
+}
+
+// sampleHistory é a resposta de history.json para a data dt, com a
+// temperatura subindo meio grau por hora a partir de 15 °C.
+func sampleHistory(dt string) HistoryResponse {
+	var history HistoryResponse
+	history.Location = sampleWeather().Location
+	day := ForecastDay{Date: dt}
+	for hour := 0; hour < 24; hour++ {
+		day.Hour = append(day.Hour, Hour{
+			Time:  fmt.Sprintf("%s %02d:00", dt, hour),
+			TempC: 15 + float64(hour)/2,
+		})
+	}
+	day.Day = Day{MintempC: 15, MaxtempC: 26.5, AvgtempC: 20.75}
+	history.Forecast.Forecastday = []ForecastDay{day}
+	return history
+}
+
+// sampleForecast é a resposta de forecast.json para days dias a partir de
+// 2025-07-10, cada dia um grau mais quente que o anterior.
+func sampleForecast(days int) ForecastResponse {
+	var forecast ForecastResponse
+	forecast.Location = sampleWeather().Location
+	start := time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < days; i++ {
+		date := start.AddDate(0, 0, i)
+		forecast.Forecast.Forecastday = append(forecast.Forecast.Forecastday, ForecastDay{
+			Date:      date.Format(DateLayout),
+			DateEpoch: date.Unix(),
+			Day: Day{
+				MintempC:  14 + float64(i),
+				MaxtempC:  27 + float64(i),
+				AvgtempC:  20 + float64(i),
+				Condition: Condition{Text: "Sunny", Code: 1000},
+			},
+		})
+	}
+	return forecast
+}
+
+// historyRequests e alertsRequests contam as chamadas a history.json e
+// forecast.json do servidor mock atual.
+var historyRequests, alertsRequests atomic.Int64
+
+// newUpstreamServer sobe o mock da ViaCEP e da WeatherAPI, com weatherData
+// como leitura atual, e limpa os caches de histórico e alertas.
+func newUpstreamServer(t *testing.T, weatherData WeatherResponse) *upstreamtest.Server {
+	t.Helper()
+
+	upstream := upstreamtest.New(t, &viacep.BaseURL, &BaseURL)
+	upstream.JSON(upstreamtest.Search, []Search{{
+		Id:      1,
+		Name:    weatherData.Location.Name,
+		Region:  weatherData.Location.Region,
+		Country: weatherData.Location.Country,
+		Lat:     weatherData.Location.Lat,
+		Lon:     weatherData.Location.Lon,
+	}})
+	upstream.JSON(upstreamtest.Current, weatherData)
+
+	historyRequests.Store(0)
+	pastHistory = &historyCache{entries: map[string]*HistoryResponse{}}
+	alertsRequests.Store(0)
+	alertsCache.entries = map[string]cachedAlerts{}
+	upstream.Handle("/v1/history.json", func(w http.ResponseWriter, r *http.Request) {
+		historyRequests.Add(1)
+		json.NewEncoder(w).Encode(sampleHistory(r.URL.Query().Get("dt")))
+	})
+	upstream.Handle("/v1/forecast.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("alerts") != "yes" {
+			days, _ := strconv.Atoi(r.URL.Query().Get("days"))
+			json.NewEncoder(w).Encode(sampleForecast(days))
+			return
+		}
+		alertsRequests.Add(1)
+		http.ServeFile(w, r, "testdata/forecast_alerts.json")
+	})
+	upstream.Handle("/v1/astronomy.json", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/astronomy.json")
+	})
+	return upstream
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"temperature_server/pkg/i18n"
 
 	"github.com/spf13/viper"
 )
 
+var BaseURL = "https://api.weatherapi.com/v1"
+
 type WeatherResponse struct {
 	Location Location `json:"location"`
 	Current  Current  `json:"current"`
@@ -56,6 +59,27 @@ type Current struct {
 	Uv               float64   `json:"uv"`
 	GustMph          float64   `json:"gust_mph"`
 	GustKph          float64   `json:"gust_kph"`
+	// AirQuality is only present when requested with aqi=yes.
+	AirQuality *AirQuality `json:"air_quality,omitempty"`
+}
+
+// AirQuality holds pollutant concentrations in μg/m³ and the US EPA (1-6)
+// and UK DEFRA (1-10) indices.
+type AirQuality struct {
+	CO           float64 `json:"co"`
+	NO2          float64 `json:"no2"`
+	O3           float64 `json:"o3"`
+	SO2          float64 `json:"so2"`
+	PM2_5        float64 `json:"pm2_5"`
+	PM10         float64 `json:"pm10"`
+	USEPAIndex   int     `json:"us-epa-index"`
+	GBDefraIndex int     `json:"gb-defra-index"`
+}
+
+// LocalizedCondition translates the condition by its code, keeping
+// WeatherAPI's English text for codes missing from the catalogs.
+func (c Current) LocalizedCondition(lang string) string {
+	return i18n.Condition(lang, c.Condition.Code, c.IsDay == 1, c.Condition.Text)
 }
 
 type Condition struct {
@@ -70,7 +94,7 @@ func FetchWeatherData(lat float64, lon float64) (*WeatherResponse, error) {
 		return nil, errors.New("WEATHER_API_KEY is not set")
 	}
 
-	url := fmt.Sprintf("https://api.weatherapi.com/v1/current.json?q=%f,%f", lat, lon)
+	url := fmt.Sprintf("%s/current.json?q=%f,%f&aqi=yes", BaseURL, lat, lon)
 	fmt.Println(url)
 
 	req, err := http.NewRequest("GET", url, nil)
@@ -0,0 +1,62 @@
+package weather
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/viper"
+)
+
+type apiError struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// coordinates formats lat, lon as WeatherAPI's q parameter.
+func coordinates(lat, lon float64) string {
+	return strconv.FormatFloat(lat, 'f', 6, 64) + "," + strconv.FormatFloat(lon, 'f', 6, 64)
+}
+
+// getJSON GETs path (for example "/history.json") from WeatherAPI with
+// query and decodes the answer into out. Errors answered by WeatherAPI
+// carry its message.
+func getJSON(path string, query url.Values, out any) error {
+	apiKey := viper.GetString("WEATHER_API_KEY")
+	if apiKey == "" {
+		return errors.New("WEATHER_API_KEY is not set")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, BaseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("key", apiKey)
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error.Message != "" {
+			return errors.New(apiErr.Error.Message)
+		}
+		return fmt.Errorf("weatherapi returned status %d", response.StatusCode)
+	}
+
+	return json.Unmarshal(body, out)
+}
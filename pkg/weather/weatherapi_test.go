@@ -0,0 +1,52 @@
+package weather
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestGetJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("key") != "test-key" {
+			t.Errorf("expected the API key header, got %q", r.Header.Get("key"))
+		}
+		switch r.URL.Path {
+		case "/v1/ok.json":
+			w.Write([]byte(`{"q": "` + r.URL.Query().Get("q") + `"}`))
+		case "/v1/invalid.json":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": 1006, "message": "No matching location found."}}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+	originalURL, originalKey := BaseURL, viper.GetString("WEATHER_API_KEY")
+	BaseURL = server.URL + "/v1"
+	t.Cleanup(func() {
+		BaseURL = originalURL
+		viper.Set("WEATHER_API_KEY", originalKey)
+	})
+
+	viper.Set("WEATHER_API_KEY", "")
+	var out struct{ Q string }
+	if err := getJSON("/ok.json", nil, &out); err == nil || err.Error() != "WEATHER_API_KEY is not set" {
+		t.Errorf("expected missing key error, got %v", err)
+	}
+
+	viper.Set("WEATHER_API_KEY", "test-key")
+	if err := getJSON("/ok.json", url.Values{"q": {coordinates(-19.72, -45.25)}}, &out); err != nil || out.Q != "-19.720000,-45.250000" {
+		t.Errorf("unexpected %+v, %v", out, err)
+	}
+	// Erros da WeatherAPI trazem a mensagem dela
+	if err := getJSON("/invalid.json", nil, &out); err == nil || err.Error() != "No matching location found." {
+		t.Errorf("expected WeatherAPI message, got %v", err)
+	}
+	if err := getJSON("/missing.json", nil, &out); err == nil || err.Error() != "weatherapi returned status 502" {
+		t.Errorf("expected status error, got %v", err)
+	}
+}